@@ -19,9 +19,12 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	api "github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
 var (
@@ -34,7 +37,8 @@ func NewApplicationDataSource() datasource.DataSource {
 }
 
 type applicationDataSource struct {
-	c APIClient
+	c     APIClient
+	debug bool
 }
 
 type (
@@ -47,6 +51,7 @@ type (
 		PlatformProvider types.String                   `tfsdk:"platform_provider"`
 		Description      types.String                   `tfsdk:"description"`
 		Git              *applicationDataSourceGitModel `tfsdk:"git"`
+		RunningVersion   types.String                   `tfsdk:"running_version"`
 	}
 
 	applicationDataSourceGitModel struct {
@@ -63,62 +68,78 @@ func (a *applicationDataSource) Metadata(_ context.Context, req datasource.Metad
 }
 
 func (a *applicationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := applicationDataSourceAttributes()
+	attrs["id"] = schema.StringAttribute{
+		Description: "The ID of this Application.",
+		Required:    true,
+	}
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "PipeCD application resource.",
 
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Description: "The ID of this Application.",
-				Required:    true,
-			},
-			"name": schema.StringAttribute{
-				Description: "The application name.",
-				Computed:    true,
-			},
-			"piped_id": schema.StringAttribute{
-				Description: "The ID of piped that should handle this application.",
-				Computed:    true,
-			},
-			"project_id": schema.StringAttribute{
-				Computed: true,
-			},
-			"kind": schema.StringAttribute{
-				Description: "The kind of application.",
-				Computed:    true,
-			},
-			"platform_provider": schema.StringAttribute{
-				Description: "The platform provider name. One of the registered providers in the piped configuration. The previous name of this field is cloud-provider.",
-				Computed:    true,
-			},
-			"description": schema.StringAttribute{
-				Description: "The description of the application.",
-				Computed:    true,
-			},
-			"git": schema.SingleNestedAttribute{
-				Description: "Git path for the application.",
-				Computed:    true,
-				Attributes: map[string]schema.Attribute{
-					"repository_id": schema.StringAttribute{
-						Description: "The repository ID. One the registered repositories in the piped configuration.",
-						Computed:    true,
-					},
-					"remote": schema.StringAttribute{
-						Computed: true,
-					},
-					"branch": schema.StringAttribute{
-						Computed: true,
-					},
-					"path": schema.StringAttribute{
-						Description: "The relative path from the root of repository to the application directory.",
-						Computed:    true,
-					},
-					"filename": schema.StringAttribute{
-						Description: "The configuration file name. (default \"app.pipecd.yaml\")",
-						Computed:    true,
-					},
+		Attributes: attrs,
+	}
+}
+
+// applicationDataSourceAttributes returns the computed attributes of an application,
+// shared by the pipecd_application and pipecd_applications data sources.
+func applicationDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of this Application.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "The application name.",
+			Computed:    true,
+		},
+		"piped_id": schema.StringAttribute{
+			Description: "The ID of piped that should handle this application.",
+			Computed:    true,
+		},
+		"project_id": schema.StringAttribute{
+			Computed: true,
+		},
+		"kind": schema.StringAttribute{
+			Description: "The kind of application.",
+			Computed:    true,
+		},
+		"platform_provider": schema.StringAttribute{
+			Description: "The platform provider name. One of the registered providers in the piped configuration. The previous name of this field is cloud-provider.",
+			Computed:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: "The description of the application.",
+			Computed:    true,
+		},
+		"git": schema.SingleNestedAttribute{
+			Description: "Git path for the application.",
+			Computed:    true,
+			Attributes: map[string]schema.Attribute{
+				"repository_id": schema.StringAttribute{
+					Description: "The repository ID. One the registered repositories in the piped configuration.",
+					Computed:    true,
+				},
+				"remote": schema.StringAttribute{
+					Computed: true,
+				},
+				"branch": schema.StringAttribute{
+					Computed: true,
+				},
+				"path": schema.StringAttribute{
+					Description: "The relative path from the root of repository to the application directory.",
+					Computed:    true,
+				},
+				"filename": schema.StringAttribute{
+					Description: "The configuration file name. (default \"app.pipecd.yaml\")",
+					Computed:    true,
 				},
 			},
 		},
+		"running_version": schema.StringAttribute{
+			Description: "The version of the most recently successful deployment, e.g. the deployed image tag. " +
+				"Null when the application has never been deployed successfully.",
+			Computed: true,
+		},
 	}
 }
 
@@ -127,7 +148,9 @@ func (a *applicationDataSource) Configure(_ context.Context, req datasource.Conf
 		return
 	}
 
-	a.c = req.ProviderData.(APIClient)
+	data := req.ProviderData.(*providerData)
+	a.c = data.c
+	a.debug = data.debug
 }
 
 func (a *applicationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -145,28 +168,47 @@ func (a *applicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read PipeCD application",
-			err.Error(),
+			errorDetail(err, a.debug),
 		)
 		return
 	}
 
-	state = applicationDataSourceModel{
-		ID:               types.StringValue(getResp.Application.Id),
-		Name:             types.StringValue(getResp.Application.Name),
-		PipedID:          types.StringValue(getResp.Application.PipedId),
-		ProjectID:        types.StringValue(getResp.Application.ProjectId),
-		Kind:             types.StringValue(getResp.Application.Kind.String()),
-		PlatformProvider: types.StringValue(getResp.Application.PlatformProvider),
-		Description:      types.StringValue(getResp.Application.Description),
-		Git: &applicationDataSourceGitModel{
-			RepositoryID: types.StringValue(getResp.Application.GitPath.Repo.Id),
-			Remote:       types.StringValue(getResp.Application.GitPath.Repo.Remote),
-			Branch:       types.StringValue(getResp.Application.GitPath.Repo.Branch),
-			Path:         types.StringValue(getResp.Application.GitPath.Path),
-			Filename:     types.StringValue(getResp.Application.GitPath.ConfigFilename),
-		},
+	state, diags = newApplicationDataSourceModel(path.Empty(), getResp.Application)
+	resp.Diagnostics.Append(diags...)
+	if state.Git == nil {
+		resp.Diagnostics.Append(missingGitPathWarning(getResp.Application.Id))
 	}
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
+
+// newApplicationDataSourceModel converts an application returned by PipeCD into the data source model.
+// Git is nil when PipeCD returns the application without its Git path.
+// p is the path of the application in the data source, used for diagnostics.
+func newApplicationDataSourceModel(p path.Path, app *model.Application) (applicationDataSourceModel, diag.Diagnostics) {
+	kind, diags := enumValue(p.AtName("kind"), app.Kind)
+	m := applicationDataSourceModel{
+		ID:               types.StringValue(app.Id),
+		Name:             types.StringValue(app.Name),
+		PipedID:          types.StringValue(app.PipedId),
+		ProjectID:        types.StringValue(app.ProjectId),
+		Kind:             kind,
+		PlatformProvider: types.StringValue(app.PlatformProvider),
+		Description:      types.StringValue(app.Description),
+		RunningVersion:   types.StringNull(),
+	}
+	if v := app.GetMostRecentlySuccessfulDeployment().GetVersion(); v != "" {
+		m.RunningVersion = types.StringValue(v)
+	}
+	if gitPath := app.GitPath; gitPath != nil {
+		m.Git = &applicationDataSourceGitModel{
+			RepositoryID: types.StringValue(gitPath.GetRepo().GetId()),
+			Remote:       types.StringValue(gitPath.GetRepo().GetRemote()),
+			Branch:       types.StringValue(gitPath.GetRepo().GetBranch()),
+			Path:         types.StringValue(gitPath.Path),
+			Filename:     types.StringValue(gitPath.ConfigFilename),
+		}
+	}
+	return m, diags
+}
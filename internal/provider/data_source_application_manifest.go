@@ -0,0 +1,262 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+var _ datasource.DataSource = &applicationManifestDataSource{}
+
+func NewApplicationManifestDataSource() datasource.DataSource {
+	return &applicationManifestDataSource{}
+}
+
+type applicationManifestDataSource struct{}
+
+type (
+	applicationManifestDataSourceModel struct {
+		Path         types.String                    `tfsdk:"path"`
+		Applications []applicationManifestEntryModel `tfsdk:"applications"`
+	}
+
+	applicationManifestEntryModel struct {
+		Name             types.String                `tfsdk:"name"`
+		PipedID          types.String                `tfsdk:"piped_id"`
+		Kind             types.String                `tfsdk:"kind"`
+		PlatformProvider types.String                `tfsdk:"platform_provider"`
+		Description      types.String                `tfsdk:"description"`
+		Git              applicationManifestGitModel `tfsdk:"git"`
+	}
+
+	applicationManifestGitModel struct {
+		RepositoryID types.String `tfsdk:"repository_id"`
+		Path         types.String `tfsdk:"path"`
+		Filename     types.String `tfsdk:"filename"`
+	}
+)
+
+// applicationManifestEntry is an application in the manifest file.
+type applicationManifestEntry struct {
+	Name             string                      `json:"name"`
+	PipedID          *string                     `json:"piped_id"`
+	Kind             string                      `json:"kind"`
+	PlatformProvider string                      `json:"platform_provider"`
+	Description      *string                     `json:"description"`
+	Git              applicationManifestEntryGit `json:"git"`
+}
+
+type applicationManifestEntryGit struct {
+	RepositoryID string `json:"repository_id"`
+	Path         string `json:"path"`
+	Filename     string `json:"filename"`
+}
+
+func (d *applicationManifestDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_manifest"
+}
+
+func (d *applicationManifestDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Parses a JSON manifest of applications, to be used with `for_each` on `pipecd_application` resources. " +
+			"The manifest is an array of objects with the same attributes as the `pipecd_application` resource.",
+
+		Attributes: map[string]schema.Attribute{
+			"path": schema.StringAttribute{
+				MarkdownDescription: "The path to the JSON manifest file.",
+				Required:            true,
+			},
+			"applications": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"piped_id": schema.StringAttribute{
+							MarkdownDescription: "Null when the entry does not set it.",
+							Computed:            true,
+						},
+						"kind": schema.StringAttribute{
+							Computed: true,
+						},
+						"platform_provider": schema.StringAttribute{
+							Computed: true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Null when the entry does not set it.",
+							Computed:            true,
+						},
+						"git": schema.SingleNestedAttribute{
+							Computed: true,
+							Attributes: map[string]schema.Attribute{
+								"repository_id": schema.StringAttribute{
+									Computed: true,
+								},
+								"path": schema.StringAttribute{
+									Computed: true,
+								},
+								"filename": schema.StringAttribute{
+									MarkdownDescription: "Defaults to \"" + model.DefaultApplicationConfigFilename + "\".",
+									Computed:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *applicationManifestDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state applicationManifestDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := os.ReadFile(state.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("path"),
+			"Unable to Read Application Manifest",
+			err.Error(),
+		)
+		return
+	}
+
+	entries, err := parseApplicationManifest(data)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("path"),
+			"Invalid Application Manifest",
+			state.Path.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Applications = make([]applicationManifestEntryModel, 0, len(entries))
+	for _, e := range entries {
+		state.Applications = append(state.Applications, applicationManifestEntryModel{
+			Name:             types.StringValue(e.Name),
+			PipedID:          types.StringPointerValue(e.PipedID),
+			Kind:             types.StringValue(e.Kind),
+			PlatformProvider: types.StringValue(e.PlatformProvider),
+			Description:      types.StringPointerValue(e.Description),
+			Git: applicationManifestGitModel{
+				RepositoryID: types.StringValue(e.Git.RepositoryID),
+				Path:         types.StringValue(e.Git.Path),
+				Filename:     types.StringValue(e.Git.Filename),
+			},
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// parseApplicationManifest parses and validates a JSON array of applications.
+// The returned errors refer to the line of the offending entry.
+func parseApplicationManifest(data []byte) ([]applicationManifestEntry, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('[') {
+		return nil, fmt.Errorf("line %d: the manifest must be a JSON array of applications", lineOf(data, dec.InputOffset()))
+	}
+
+	var entries []applicationManifestEntry
+	for i := 0; dec.More(); i++ {
+		line := lineOf(data, dec.InputOffset())
+		var e applicationManifestEntry
+		if err := dec.Decode(&e); err != nil {
+			var syntaxErr *json.SyntaxError
+			if errors.As(err, &syntaxErr) {
+				line = lineOf(data, syntaxErr.Offset)
+			}
+			return nil, fmt.Errorf("line %d: application %d: %w", line, i, err)
+		}
+		if err := e.validate(); err != nil {
+			return nil, fmt.Errorf("line %d: application %d: %w", line, i, err)
+		}
+		if e.Git.Filename == "" {
+			e.Git.Filename = model.DefaultApplicationConfigFilename
+		}
+		entries = append(entries, e)
+	}
+
+	if _, err := dec.Token(); err != nil {
+		return nil, fmt.Errorf("line %d: %w", lineOf(data, dec.InputOffset()), err)
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("line %d: unexpected data after the array of applications", lineOf(data, dec.InputOffset()))
+	}
+	return entries, nil
+}
+
+func (e *applicationManifestEntry) validate() error {
+	var missing []string
+	for _, f := range []struct {
+		name  string
+		value string
+	}{
+		{"name", e.Name},
+		{"kind", e.Kind},
+		{"platform_provider", e.PlatformProvider},
+		{"git.repository_id", e.Git.RepositoryID},
+		{"git.path", e.Git.Path},
+	} {
+		if f.value == "" {
+			missing = append(missing, f.name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("missing required fields: %s", strings.Join(missing, ", "))
+	}
+
+	if !isValidName(e.Name) {
+		return fmt.Errorf("name %q must not contain control characters", e.Name)
+	}
+	if _, ok := model.ApplicationKind_value[e.Kind]; !ok {
+		return fmt.Errorf("unknown kind %q", e.Kind)
+	}
+	return nil
+}
+
+// lineOf returns the 1-based line of the first non-whitespace character at or after offset in data.
+func lineOf(data []byte, offset int64) int {
+	if offset > int64(len(data)) {
+		offset = int64(len(data))
+	}
+	rest := data[offset:]
+	skipped := len(rest) - len(bytes.TrimLeft(rest, " \t\r\n,"))
+	return bytes.Count(data[:offset+int64(skipped)], []byte("\n")) + 1
+}
@@ -0,0 +1,165 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/pipe-cd/terraform-provider-pipecd/internal/provider/mock"
+)
+
+const testApplicationManifest = `[
+  {
+    "name": "app-1",
+    "piped_id": "piped-1",
+    "kind": "KUBERNETES",
+    "platform_provider": "kubernetes-default",
+    "git": {
+      "repository_id": "repo",
+      "path": "apps/app-1"
+    }
+  },
+  {
+    "name": "app-2",
+    "kind": "CLOUDRUN",
+    "platform_provider": "cloudrun-default",
+    "description": "The second application",
+    "git": {
+      "repository_id": "repo",
+      "path": "apps/app-2",
+      "filename": "service.pipecd.yaml"
+    }
+  }
+]`
+
+func TestAccDataSourceApplicationManifest(t *testing.T) {
+	t.Parallel()
+
+	manifest := filepath.Join(t.TempDir(), "applications.json")
+	if err := os.WriteFile(manifest, []byte(testApplicationManifest), 0o600); err != nil {
+		t.Errorf("failed to write manifest: %v", err)
+		return
+	}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "pipecd_application_manifest" "test" {
+	path = "` + manifest + `"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pipecd_application_manifest.test", "applications.#", "2"),
+					resource.TestCheckResourceAttr("data.pipecd_application_manifest.test", "applications.0.name", "app-1"),
+					resource.TestCheckResourceAttr("data.pipecd_application_manifest.test", "applications.0.piped_id", "piped-1"),
+					resource.TestCheckNoResourceAttr("data.pipecd_application_manifest.test", "applications.0.description"),
+					resource.TestCheckResourceAttr("data.pipecd_application_manifest.test", "applications.0.git.filename", "app.pipecd.yaml"),
+					resource.TestCheckNoResourceAttr("data.pipecd_application_manifest.test", "applications.1.piped_id"),
+					resource.TestCheckResourceAttr("data.pipecd_application_manifest.test", "applications.1.kind", "CLOUDRUN"),
+					resource.TestCheckResourceAttr("data.pipecd_application_manifest.test", "applications.1.description", "The second application"),
+					resource.TestCheckResourceAttr("data.pipecd_application_manifest.test", "applications.1.git.filename", "service.pipecd.yaml"),
+				),
+			},
+		},
+	})
+}
+
+func TestParseApplicationManifest(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		manifest string
+		wantErr  string
+	}{
+		{
+			name:     "valid",
+			manifest: testApplicationManifest,
+		},
+		{
+			name:     "empty",
+			manifest: `[]`,
+		},
+		{
+			name:     "not an array",
+			manifest: `{"name": "app-1"}`,
+			wantErr:  "line 1: the manifest must be a JSON array of applications",
+		},
+		{
+			name: "missing fields",
+			manifest: `[
+  {"name": "app-1", "kind": "KUBERNETES", "platform_provider": "k8s", "git": {"repository_id": "repo", "path": "app-1"}},
+  {"name": "app-2", "kind": "KUBERNETES", "git": {"repository_id": "repo"}}
+]`,
+			wantErr: "line 3: application 1: missing required fields: platform_provider, git.path",
+		},
+		{
+			name: "unknown kind",
+			manifest: `[
+  {"name": "app-1", "kind": "HELM", "platform_provider": "k8s", "git": {"repository_id": "repo", "path": "app-1"}}
+]`,
+			wantErr: `line 2: application 0: unknown kind "HELM"`,
+		},
+		{
+			name: "control character in name",
+			manifest: `[
+  {"name": "app\t1", "kind": "KUBERNETES", "platform_provider": "k8s", "git": {"repository_id": "repo", "path": "app-1"}}
+]`,
+			wantErr: `line 2: application 0: name "app\t1" must not contain control characters`,
+		},
+		{
+			name: "unknown field",
+			manifest: `[
+  {"name": "app-1", "kinds": "KUBERNETES"}
+]`,
+			wantErr: `line 2: application 0: json: unknown field "kinds"`,
+		},
+		{
+			name: "malformed entry",
+			manifest: `[
+  {"name": "app-1", "kind": "KUBERNETES", "platform_provider": "k8s", "git": {"repository_id": "repo", "path": "app-1"}},
+  {"name": "app-2",
+   "kind": KUBERNETES}
+]`,
+			wantErr: "line 4: application 1: invalid character 'K' looking for beginning of value",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := parseApplicationManifest([]byte(tc.manifest))
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("expected error %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,251 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	api "github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+var (
+	_ datasource.DataSource              = &applicationReadinessDataSource{}
+	_ datasource.DataSourceWithConfigure = &applicationReadinessDataSource{}
+)
+
+const (
+	defaultApplicationReadinessWaitTimeout = "5m"
+	applicationReadinessPollInterval       = 10 * time.Second
+)
+
+func NewApplicationReadinessDataSource() datasource.DataSource {
+	return &applicationReadinessDataSource{}
+}
+
+type applicationReadinessDataSource struct {
+	c     APIClient
+	debug bool
+}
+
+type (
+	applicationReadinessDataSourceModel struct {
+		ApplicationID types.String                `tfsdk:"application_id"`
+		Wait          types.Bool                  `tfsdk:"wait"`
+		WaitTimeout   types.String                `tfsdk:"wait_timeout"`
+		Ready         types.Bool                  `tfsdk:"ready"`
+		Checks        []applicationReadinessCheck `tfsdk:"checks"`
+	}
+
+	applicationReadinessCheck struct {
+		Name   types.String `tfsdk:"name"`
+		Passed types.Bool   `tfsdk:"passed"`
+		Reason types.String `tfsdk:"reason"`
+	}
+)
+
+func (d *applicationReadinessDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_readiness"
+}
+
+func (d *applicationReadinessDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reports whether a PipeCD application is ready to be deployed: " +
+			"the application exists, it is enabled and its piped is online.",
+
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Required: true,
+			},
+			"wait": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait until the application is ready.",
+				Optional:            true,
+			},
+			"wait_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to wait for the application to be ready when wait is set. (default \"" + defaultApplicationReadinessWaitTimeout + "\")",
+				Optional:            true,
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"ready": schema.BoolAttribute{
+				MarkdownDescription: "Whether all the checks passed.",
+				Computed:            true,
+			},
+			"checks": schema.ListNestedAttribute{
+				MarkdownDescription: "The result of each check, in the order they are evaluated.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "One of `application_exists`, `application_enabled` and `piped_online`.",
+							Computed:            true,
+						},
+						"passed": schema.BoolAttribute{
+							Computed: true,
+						},
+						"reason": schema.StringAttribute{
+							MarkdownDescription: "Why the check did not pass. Empty when it passed.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *applicationReadinessDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	d.c = data.c
+	d.debug = data.debug
+}
+
+func (d *applicationReadinessDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state applicationReadinessDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	appID := state.ApplicationID.ValueString()
+
+	var checks []applicationReadinessCheck
+	var err error
+	if state.Wait.ValueBool() {
+		timeoutValue := defaultApplicationReadinessWaitTimeout
+		if !state.WaitTimeout.IsNull() {
+			timeoutValue = state.WaitTimeout.ValueString()
+		}
+		timeout, _ := time.ParseDuration(timeoutValue)
+
+		waitCtx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		checks, err = waitForApplicationReadiness(waitCtx, d.c, appID, applicationReadinessPollInterval)
+		if errors.Is(err, context.DeadlineExceeded) {
+			resp.Diagnostics.AddWarning(
+				"Application is not ready",
+				"The application "+appID+" did not become ready within "+timeout.String()+".",
+			)
+			err = nil
+		}
+	} else {
+		checks, err = checkApplicationReadiness(ctx, d.c, appID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read PipeCD application readiness",
+			errorDetail(err, d.debug),
+		)
+		return
+	}
+
+	state.Ready = types.BoolValue(allPassed(checks))
+	state.Checks = checks
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// waitForApplicationReadiness runs the readiness checks every interval until all of them pass.
+// The last results are returned together with the context error when ctx is done before that.
+func waitForApplicationReadiness(ctx context.Context, c APIClient, appID string, interval time.Duration) ([]applicationReadinessCheck, error) {
+	var checks []applicationReadinessCheck
+	err := poll(ctx, interval, func(ctx context.Context) (bool, error) {
+		results, err := checkApplicationReadiness(ctx, c, appID)
+		if err != nil {
+			// Report the timeout rather than the error of the RPC it interrupted.
+			if ctx.Err() != nil {
+				return false, ctx.Err()
+			}
+			return false, err
+		}
+		checks = results
+		return allPassed(checks), nil
+	})
+	return checks, err
+}
+
+// checkApplicationReadiness checks that the application exists, is enabled and that its piped is online.
+// The checks after a failed existence check are reported as not passed.
+func checkApplicationReadiness(ctx context.Context, c APIClient, appID string) ([]applicationReadinessCheck, error) {
+	checks := make([]applicationReadinessCheck, 0, 3)
+
+	appResp, err := c.GetApplication(ctx, &api.GetApplicationRequest{ApplicationId: appID})
+	if isNotFound(err) {
+		reason := "the application " + appID + " does not exist"
+		return append(checks,
+			readinessCheck("application_exists", reason),
+			readinessCheck("application_enabled", reason),
+			readinessCheck("piped_online", reason),
+		), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	app := appResp.Application
+	checks = append(checks, readinessCheck("application_exists", ""))
+
+	if app.Disabled {
+		checks = append(checks, readinessCheck("application_enabled", "the application is disabled"))
+	} else {
+		checks = append(checks, readinessCheck("application_enabled", ""))
+	}
+
+	pipedResp, err := c.GetPiped(ctx, &api.GetPipedRequest{PipedId: app.PipedId})
+	switch {
+	case isNotFound(err):
+		checks = append(checks, readinessCheck("piped_online", "the piped "+app.PipedId+" does not exist"))
+	case err != nil:
+		return nil, err
+	case pipedResp.Piped.Status != model.Piped_ONLINE:
+		checks = append(checks, readinessCheck("piped_online", "the piped "+app.PipedId+" is "+pipedResp.Piped.Status.String()))
+	default:
+		checks = append(checks, readinessCheck("piped_online", ""))
+	}
+
+	return checks, nil
+}
+
+// readinessCheck returns a check with the given name, which passed when reason is empty.
+func readinessCheck(name, reason string) applicationReadinessCheck {
+	return applicationReadinessCheck{
+		Name:   types.StringValue(name),
+		Passed: types.BoolValue(reason == ""),
+		Reason: types.StringValue(reason),
+	}
+}
+
+func allPassed(checks []applicationReadinessCheck) bool {
+	for _, c := range checks {
+		if !c.Passed.ValueBool() {
+			return false
+		}
+	}
+	return len(checks) > 0
+}
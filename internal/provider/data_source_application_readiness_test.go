@@ -0,0 +1,139 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/model"
+	"github.com/pipe-cd/terraform-provider-pipecd/internal/provider/mock"
+)
+
+func TestAccDataSourceApplicationReadiness(t *testing.T) {
+	t.Parallel()
+
+	const (
+		appID   = "test_application_id"
+		pipedID = "test_piped_id"
+	)
+
+	testcases := []struct {
+		name        string
+		pipedStatus model.Piped_ConnectionStatus
+		checks      resource.TestCheckFunc
+	}{
+		{
+			name:        "ready",
+			pipedStatus: model.Piped_ONLINE,
+			checks: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("data.pipecd_application_readiness.test", "ready", "true"),
+				resource.TestCheckResourceAttr("data.pipecd_application_readiness.test", "checks.#", "3"),
+				resource.TestCheckResourceAttr("data.pipecd_application_readiness.test", "checks.2.name", "piped_online"),
+				resource.TestCheckResourceAttr("data.pipecd_application_readiness.test", "checks.2.passed", "true"),
+				resource.TestCheckResourceAttr("data.pipecd_application_readiness.test", "checks.2.reason", ""),
+			),
+		},
+		{
+			name:        "piped is offline",
+			pipedStatus: model.Piped_OFFLINE,
+			checks: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("data.pipecd_application_readiness.test", "ready", "false"),
+				resource.TestCheckResourceAttr("data.pipecd_application_readiness.test", "checks.0.passed", "true"),
+				resource.TestCheckResourceAttr("data.pipecd_application_readiness.test", "checks.1.passed", "true"),
+				resource.TestCheckResourceAttr("data.pipecd_application_readiness.test", "checks.2.name", "piped_online"),
+				resource.TestCheckResourceAttr("data.pipecd_application_readiness.test", "checks.2.passed", "false"),
+				resource.TestCheckResourceAttr("data.pipecd_application_readiness.test", "checks.2.reason", "the piped test_piped_id is OFFLINE"),
+			),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			getAppReq := &apiservice.GetApplicationRequest{ApplicationId: appID}
+			getAppResp := &apiservice.GetApplicationResponse{
+				Application: &model.Application{Id: appID, PipedId: pipedID},
+			}
+			getPipedReq := &apiservice.GetPipedRequest{PipedId: pipedID}
+			getPipedResp := &apiservice.GetPipedResponse{
+				Piped: &model.Piped{Id: pipedID, Status: tc.pipedStatus},
+			}
+
+			ctrl := gomock.NewController(t)
+			client := mock.NewMockAPIClient(ctrl)
+			client.EXPECT().GetApplication(gomock.Any(), protoEq(getAppReq)).Return(getAppResp, nil).AnyTimes()
+			client.EXPECT().GetPiped(gomock.Any(), protoEq(getPipedReq)).Return(getPipedResp, nil).AnyTimes()
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + fmt.Sprintf(`
+data "pipecd_application_readiness" "test" {
+	application_id = "%s"
+}`, appID),
+						Check: tc.checks,
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestWaitForApplicationReadiness(t *testing.T) {
+	t.Parallel()
+
+	const (
+		appID   = "test_application_id"
+		pipedID = "test_piped_id"
+	)
+
+	getAppReq := &apiservice.GetApplicationRequest{ApplicationId: appID}
+	getAppResp := &apiservice.GetApplicationResponse{
+		Application: &model.Application{Id: appID, PipedId: pipedID},
+	}
+	getPipedReq := &apiservice.GetPipedRequest{PipedId: pipedID}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	client.EXPECT().GetApplication(gomock.Any(), protoEq(getAppReq)).Return(getAppResp, nil).Times(2)
+	gomock.InOrder(
+		client.EXPECT().GetPiped(gomock.Any(), protoEq(getPipedReq)).Return(&apiservice.GetPipedResponse{
+			Piped: &model.Piped{Id: pipedID, Status: model.Piped_OFFLINE},
+		}, nil),
+		client.EXPECT().GetPiped(gomock.Any(), protoEq(getPipedReq)).Return(&apiservice.GetPipedResponse{
+			Piped: &model.Piped{Id: pipedID, Status: model.Piped_ONLINE},
+		}, nil),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	checks, err := waitForApplicationReadiness(ctx, client, appID, 10*time.Millisecond)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if !allPassed(checks) {
+		t.Errorf("expected all checks to pass, got %v", checks)
+	}
+}
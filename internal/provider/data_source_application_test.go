@@ -51,12 +51,16 @@ func TestAccDataSourceApplication(t *testing.T) {
 				ConfigFilename: "test_git_config_filename",
 				Url:            "test_git_url",
 			},
+			MostRecentlySuccessfulDeployment: &model.ApplicationDeploymentReference{
+				DeploymentId: "test_deployment_id",
+				Version:      "v1.2.3",
+			},
 		},
 	}
 
 	ctrl := gomock.NewController(t)
 	client := mock.NewMockAPIClient(ctrl)
-	client.EXPECT().GetApplication(gomock.Any(), getReq).Return(getResp, nil).AnyTimes()
+	client.EXPECT().GetApplication(gomock.Any(), protoEq(getReq)).Return(getResp, nil).AnyTimes()
 
 	resource.UnitTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
@@ -76,6 +80,43 @@ func TestAccDataSourceApplication(t *testing.T) {
 					resource.TestCheckResourceAttr("data.pipecd_application.test", "git.branch", "test_repo_branch"),
 					resource.TestCheckResourceAttr("data.pipecd_application.test", "git.path", "test_git_path"),
 					resource.TestCheckResourceAttr("data.pipecd_application.test", "git.filename", "test_git_config_filename"),
+					resource.TestCheckResourceAttr("data.pipecd_application.test", "running_version", "v1.2.3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceApplicationWithoutGitPath(t *testing.T) {
+	t.Parallel()
+
+	const appID = "test_application_id"
+
+	getReq := &apiservice.GetApplicationRequest{ApplicationId: appID}
+	getResp := &apiservice.GetApplicationResponse{
+		Application: &model.Application{
+			Id:               appID,
+			Name:             "test_name",
+			PipedId:          "test_piped_id",
+			Kind:             model.ApplicationKind_KUBERNETES,
+			PlatformProvider: "test_provider",
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	client.EXPECT().GetApplication(gomock.Any(), protoEq(getReq)).Return(getResp, nil).AnyTimes()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceApplication(appID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pipecd_application.test", "id", appID),
+					resource.TestCheckNoResourceAttr("data.pipecd_application.test", "git.repository_id"),
+					resource.TestCheckNoResourceAttr("data.pipecd_application.test", "git.path"),
+					resource.TestCheckNoResourceAttr("data.pipecd_application.test", "running_version"),
 				),
 			},
 		},
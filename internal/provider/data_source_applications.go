@@ -0,0 +1,197 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/protobuf/proto"
+
+	api "github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+var (
+	_ datasource.DataSource              = &applicationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &applicationsDataSource{}
+)
+
+func NewApplicationsDataSource() datasource.DataSource {
+	return &applicationsDataSource{}
+}
+
+type applicationsDataSource struct {
+	c     APIClient
+	debug bool
+}
+
+type applicationsDataSourceModel struct {
+	PipedID          types.String                 `tfsdk:"piped_id"`
+	Kind             types.String                 `tfsdk:"kind"`
+	Kinds            []types.String               `tfsdk:"kinds"`
+	PlatformProvider types.String                 `tfsdk:"platform_provider"`
+	Labels           types.Map                    `tfsdk:"labels"`
+	Applications     []applicationDataSourceModel `tfsdk:"applications"`
+}
+
+func (a *applicationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_applications"
+}
+
+func (a *applicationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	kinds := make([]string, 0, len(model.ApplicationKind_value))
+	for k := range model.ApplicationKind_value {
+		kinds = append(kinds, k)
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "PipeCD applications data source. Lists the enabled applications of the project matching all the given filters. " +
+			"The `id` of each listed application can be used to import it into a `pipecd_application` resource, " +
+			"e.g. in the `id` of an `import` block generated for each of them.",
+
+		Attributes: map[string]schema.Attribute{
+			"piped_id": schema.StringAttribute{
+				Description: "Only list the applications handled by this piped.",
+				Optional:    true,
+			},
+			"kind": schema.StringAttribute{
+				Description: "Only list the applications of this kind.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(kinds...),
+				},
+			},
+			"kinds": schema.ListAttribute{
+				Description: "Only list the applications of one of these kinds. Conflicts with kind.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(kinds...)),
+					listvalidator.ConflictsWith(path.MatchRoot("kind")),
+				},
+			},
+			"platform_provider": schema.StringAttribute{
+				Description: "Only list the applications using this platform provider.",
+				Optional:    true,
+			},
+			"labels": schema.MapAttribute{
+				Description: "Only list the applications having all these labels.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"applications": schema.ListNestedAttribute{
+				Description: "The matching applications. Empty when no application matches.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: applicationDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (a *applicationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	a.c = data.c
+	a.debug = data.debug
+}
+
+func (a *applicationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state applicationsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var labels map[string]string
+	resp.Diagnostics.Append(state.Labels.ElementsAs(ctx, &labels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listReq := &api.ListApplicationsRequest{
+		PipedId: state.PipedID.ValueString(),
+		Kind:    state.Kind.ValueString(),
+		Labels:  labels,
+	}
+	// ListApplications only filters by a single kind, so more of them are filtered here.
+	kinds := make(map[string]bool, len(state.Kinds))
+	for _, k := range state.Kinds {
+		kinds[k.ValueString()] = true
+	}
+	if len(kinds) == 1 {
+		for k := range kinds {
+			listReq.Kind = k
+		}
+	}
+	apps, err := listApplications(ctx, a.c, listReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to List PipeCD applications",
+			errorDetail(err, a.debug),
+		)
+		return
+	}
+
+	state.Applications = make([]applicationDataSourceModel, 0, len(apps))
+	for _, app := range apps {
+		// ListApplications cannot filter by platform provider.
+		if !state.PlatformProvider.IsNull() && app.PlatformProvider != state.PlatformProvider.ValueString() {
+			continue
+		}
+		if len(kinds) > 0 && !kinds[app.Kind.String()] {
+			continue
+		}
+		m, diags := newApplicationDataSourceModel(path.Root("applications").AtListIndex(len(state.Applications)), app)
+		resp.Diagnostics.Append(diags...)
+		if m.Git == nil {
+			resp.Diagnostics.Append(missingGitPathWarning(app.Id))
+		}
+		state.Applications = append(state.Applications, m)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// listApplications calls ListApplications with req, following the returned cursor until all pages are read.
+// The page size is left to the server, which returns all the matching applications in one page unless labels are given.
+func listApplications(ctx context.Context, c APIClient, req *api.ListApplicationsRequest) ([]*model.Application, error) {
+	var apps []*model.Application
+	for {
+		listResp, err := c.ListApplications(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		apps = append(apps, listResp.Applications...)
+		// The cursor is also returned with the last page, which is followed by an empty one.
+		if listResp.Cursor == "" || len(listResp.Applications) == 0 {
+			return apps, nil
+		}
+		req = proto.Clone(req).(*api.ListApplicationsRequest)
+		req.Cursor = listResp.Cursor
+	}
+}
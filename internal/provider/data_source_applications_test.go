@@ -0,0 +1,201 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/model"
+	"github.com/pipe-cd/terraform-provider-pipecd/internal/provider/mock"
+)
+
+func TestAccDataSourceApplications(t *testing.T) {
+	t.Parallel()
+
+	newApp := func(id, platformProvider string) *model.Application {
+		return &model.Application{
+			Id:               id,
+			Name:             id + "_name",
+			PipedId:          "test_piped_id",
+			Kind:             model.ApplicationKind_KUBERNETES,
+			PlatformProvider: platformProvider,
+			Labels:           map[string]string{"team": "payments"},
+			GitPath: &model.ApplicationGitPath{
+				Repo: &model.ApplicationGitRepository{Id: "test_repo_id"},
+				Path: "path/to/" + id,
+			},
+		}
+	}
+
+	firstReq := &apiservice.ListApplicationsRequest{
+		PipedId: "test_piped_id",
+		Kind:    "KUBERNETES",
+		Labels:  map[string]string{"team": "payments"},
+	}
+	firstResp := &apiservice.ListApplicationsResponse{
+		Applications: []*model.Application{newApp("app-1", "kubernetes-default"), newApp("app-2", "kubernetes-other")},
+		Cursor:       "test_cursor_1",
+	}
+	secondReq := &apiservice.ListApplicationsRequest{
+		PipedId: "test_piped_id",
+		Kind:    "KUBERNETES",
+		Labels:  map[string]string{"team": "payments"},
+		Cursor:  "test_cursor_1",
+	}
+	secondResp := &apiservice.ListApplicationsResponse{
+		Applications: []*model.Application{newApp("app-3", "kubernetes-default")},
+	}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	client.EXPECT().ListApplications(gomock.Any(), protoEq(firstReq)).Return(firstResp, nil).MinTimes(1)
+	client.EXPECT().ListApplications(gomock.Any(), protoEq(secondReq)).Return(secondResp, nil).MinTimes(1)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceApplications(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pipecd_applications.test", "applications.#", "2"),
+					resource.TestCheckResourceAttr("data.pipecd_applications.test", "applications.0.id", "app-1"),
+					resource.TestCheckResourceAttr("data.pipecd_applications.test", "applications.0.name", "app-1_name"),
+					resource.TestCheckResourceAttr("data.pipecd_applications.test", "applications.0.kind", "KUBERNETES"),
+					resource.TestCheckResourceAttr("data.pipecd_applications.test", "applications.0.git.path", "path/to/app-1"),
+					resource.TestCheckResourceAttr("data.pipecd_applications.test", "applications.1.id", "app-3"),
+					resource.TestCheckResourceAttr("data.pipecd_applications.test", "applications.1.platform_provider", "kubernetes-default"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceApplicationsEmpty(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	client.EXPECT().ListApplications(gomock.Any(), protoEq(&apiservice.ListApplicationsRequest{})).
+		Return(&apiservice.ListApplicationsResponse{}, nil).AnyTimes()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "pipecd_applications" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pipecd_applications.test", "applications.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceApplicationsKinds(t *testing.T) {
+	t.Parallel()
+
+	newApp := func(id string, kind model.ApplicationKind) *model.Application {
+		return &model.Application{
+			Id:      id,
+			Name:    id + "_name",
+			PipedId: "test_piped_id",
+			Kind:    kind,
+			GitPath: &model.ApplicationGitPath{
+				Repo: &model.ApplicationGitRepository{Id: "test_repo_id"},
+				Path: "path/to/" + id,
+			},
+		}
+	}
+
+	// Several kinds cannot be passed to ListApplications, so all the applications are listed.
+	listReq := &apiservice.ListApplicationsRequest{}
+	listResp := &apiservice.ListApplicationsResponse{
+		Applications: []*model.Application{
+			newApp("app-1", model.ApplicationKind_KUBERNETES),
+			newApp("app-2", model.ApplicationKind_ECS),
+			newApp("app-3", model.ApplicationKind_CLOUDRUN),
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	client.EXPECT().ListApplications(gomock.Any(), protoEq(listReq)).Return(listResp, nil).MinTimes(1)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "pipecd_applications" "test" {
+	kinds = ["KUBERNETES", "CLOUDRUN"]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pipecd_applications.test", "applications.#", "2"),
+					resource.TestCheckResourceAttr("data.pipecd_applications.test", "applications.0.id", "app-1"),
+					resource.TestCheckResourceAttr("data.pipecd_applications.test", "applications.0.kind", "KUBERNETES"),
+					resource.TestCheckResourceAttr("data.pipecd_applications.test", "applications.1.id", "app-3"),
+					resource.TestCheckResourceAttr("data.pipecd_applications.test", "applications.1.kind", "CLOUDRUN"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceApplicationsSingleKind(t *testing.T) {
+	t.Parallel()
+
+	// A single kind is passed to ListApplications.
+	listReq := &apiservice.ListApplicationsRequest{Kind: "ECS"}
+	listResp := &apiservice.ListApplicationsResponse{
+		Applications: []*model.Application{{Id: "app-1", Kind: model.ApplicationKind_ECS}},
+	}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	client.EXPECT().ListApplications(gomock.Any(), protoEq(listReq)).Return(listResp, nil).MinTimes(1)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "pipecd_applications" "test" {
+	kinds = ["ECS"]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pipecd_applications.test", "applications.#", "1"),
+					resource.TestCheckResourceAttr("data.pipecd_applications.test", "applications.0.id", "app-1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceApplications() string {
+	return providerConfig + `
+data "pipecd_applications" "test" {
+	piped_id = "test_piped_id"
+	kind = "KUBERNETES"
+	platform_provider = "kubernetes-default"
+	labels = {
+		team = "payments"
+	}
+}`
+}
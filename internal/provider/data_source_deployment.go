@@ -0,0 +1,244 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	api "github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+var (
+	_ datasource.DataSource              = &deploymentDataSource{}
+	_ datasource.DataSourceWithConfigure = &deploymentDataSource{}
+)
+
+func NewDeploymentDataSource() datasource.DataSource {
+	return &deploymentDataSource{}
+}
+
+type deploymentDataSource struct {
+	c     APIClient
+	debug bool
+}
+
+type (
+	deploymentDataSourceModel struct {
+		ID            types.String                 `tfsdk:"id"`
+		ApplicationID types.String                 `tfsdk:"application_id"`
+		Status        types.String                 `tfsdk:"status"`
+		StageStatuses []deploymentStageStatusModel `tfsdk:"stage_statuses"`
+		Trigger       *deploymentTriggerModel      `tfsdk:"trigger"`
+		Version       types.String                 `tfsdk:"version"`
+		CreatedAt     types.String                 `tfsdk:"created_at"`
+		CompletedAt   types.String                 `tfsdk:"completed_at"`
+	}
+
+	deploymentStageStatusModel struct {
+		ID     types.String `tfsdk:"id"`
+		Name   types.String `tfsdk:"name"`
+		Status types.String `tfsdk:"status"`
+	}
+
+	deploymentTriggerModel struct {
+		Commit    deploymentCommitModel `tfsdk:"commit"`
+		Commander types.String          `tfsdk:"commander"`
+	}
+
+	deploymentCommitModel struct {
+		Hash    types.String `tfsdk:"hash"`
+		Message types.String `tfsdk:"message"`
+		Branch  types.String `tfsdk:"branch"`
+	}
+)
+
+func (d *deploymentDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment"
+}
+
+// deploymentTriggerAttribute is the schema of the trigger of a deployment.
+func deploymentTriggerAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "What triggered the deployment.",
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"commit": schema.SingleNestedAttribute{
+				MarkdownDescription: "The commit the deployment was triggered for.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"hash": schema.StringAttribute{
+						Computed: true,
+					},
+					"message": schema.StringAttribute{
+						Computed: true,
+					},
+					"branch": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+			},
+			"commander": schema.StringAttribute{
+				MarkdownDescription: "The user who triggered the deployment from the web console, if any.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *deploymentDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "PipeCD deployment data source. It can be used to gate other resources on the result of a deployment.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required: true,
+			},
+			"application_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the deployment, e.g. `DEPLOYMENT_SUCCESS`.",
+				Computed:            true,
+			},
+			"stage_statuses": schema.ListNestedAttribute{
+				MarkdownDescription: "The statuses of the stages of the deployment, ordered by their index.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The status of the stage, e.g. `STAGE_SUCCESS`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"trigger": deploymentTriggerAttribute(),
+			"version": schema.StringAttribute{
+				MarkdownDescription: "The version of the artifacts being deployed.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "When the deployment was created, in RFC 3339 format.",
+				Computed:            true,
+			},
+			"completed_at": schema.StringAttribute{
+				MarkdownDescription: "When the deployment completed, in RFC 3339 format. Null while it is running.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *deploymentDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	d.c = data.c
+	d.debug = data.debug
+}
+
+func (d *deploymentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state deploymentDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getReq := &api.GetDeploymentRequest{
+		DeploymentId: state.ID.ValueString(),
+	}
+	getResp, err := d.c.GetDeployment(ctx, getReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read PipeCD deployment",
+			errorDetail(err, d.debug),
+		)
+		return
+	}
+	deployment := getResp.Deployment
+
+	status, diags := enumValue(path.Root("status"), deployment.Status)
+	resp.Diagnostics.Append(diags...)
+	stages, diags := deploymentStageStatuses(deployment.Stages)
+	resp.Diagnostics.Append(diags...)
+
+	state = deploymentDataSourceModel{
+		ID:            state.ID,
+		ApplicationID: types.StringValue(deployment.ApplicationId),
+		Status:        status,
+		StageStatuses: stages,
+		Trigger:       newDeploymentTriggerModel(deployment.Trigger),
+		Version:       types.StringValue(deployment.Version),
+		CreatedAt:     timestampValue(deployment.CreatedAt),
+		CompletedAt:   timestampValue(deployment.CompletedAt),
+	}
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// deploymentStageStatuses returns the statuses of the given stages ordered by their index.
+func deploymentStageStatuses(stages []*model.PipelineStage) ([]deploymentStageStatusModel, diag.Diagnostics) {
+	stages, statuses, diags := stagesByIndex(path.Root("stage_statuses"), stages)
+
+	models := make([]deploymentStageStatusModel, 0, len(stages))
+	for i, s := range stages {
+		models = append(models, deploymentStageStatusModel{
+			ID:     types.StringValue(s.Id),
+			Name:   types.StringValue(s.Name),
+			Status: statuses[i],
+		})
+	}
+	return models, diags
+}
+
+// newDeploymentTriggerModel converts the trigger of a deployment, which is nil when PipeCD returns none.
+func newDeploymentTriggerModel(trigger *model.DeploymentTrigger) *deploymentTriggerModel {
+	if trigger == nil {
+		return nil
+	}
+	return &deploymentTriggerModel{
+		Commit: deploymentCommitModel{
+			Hash:    types.StringValue(trigger.GetCommit().GetHash()),
+			Message: types.StringValue(trigger.GetCommit().GetMessage()),
+			Branch:  types.StringValue(trigger.GetCommit().GetBranch()),
+		},
+		Commander: types.StringValue(trigger.Commander),
+	}
+}
+
+// timestampValue converts a Unix time in seconds returned by PipeCD into RFC 3339, or null when it is not set.
+func timestampValue(sec int64) types.String {
+	if sec == 0 {
+		return types.StringNull()
+	}
+	return types.StringValue(time.Unix(sec, 0).UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,182 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	api "github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+var (
+	_ datasource.DataSource              = &deploymentStagesDataSource{}
+	_ datasource.DataSourceWithConfigure = &deploymentStagesDataSource{}
+)
+
+func NewDeploymentStagesDataSource() datasource.DataSource {
+	return &deploymentStagesDataSource{}
+}
+
+type deploymentStagesDataSource struct {
+	c     APIClient
+	debug bool
+}
+
+type (
+	deploymentStagesDataSourceModel struct {
+		DeploymentID types.String                     `tfsdk:"deployment_id"`
+		Stages       []deploymentStageDataSourceModel `tfsdk:"stages"`
+	}
+
+	deploymentStageDataSourceModel struct {
+		ID               types.String `tfsdk:"id"`
+		Name             types.String `tfsdk:"name"`
+		Description      types.String `tfsdk:"description"`
+		Index            types.Int64  `tfsdk:"index"`
+		Status           types.String `tfsdk:"status"`
+		StatusReason     types.String `tfsdk:"status_reason"`
+		RequiresApproval types.Bool   `tfsdk:"requires_approval"`
+		Rollback         types.Bool   `tfsdk:"rollback"`
+	}
+)
+
+func (d *deploymentStagesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_stages"
+}
+
+func (d *deploymentStagesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "PipeCD deployment stages data source.",
+
+		Attributes: map[string]schema.Attribute{
+			"deployment_id": schema.StringAttribute{
+				Required: true,
+			},
+			"stages": schema.ListNestedAttribute{
+				MarkdownDescription: "The stages of the deployment, ordered by their index. Empty when the deployment has no stages yet.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+						"index": schema.Int64Attribute{
+							Computed: true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The status of the stage, e.g. `STAGE_RUNNING`.",
+							Computed:            true,
+						},
+						"status_reason": schema.StringAttribute{
+							Computed: true,
+						},
+						"requires_approval": schema.BoolAttribute{
+							MarkdownDescription: "Whether the stage is a `WAIT_APPROVAL` stage.",
+							Computed:            true,
+						},
+						"rollback": schema.BoolAttribute{
+							MarkdownDescription: "Whether the stage is executed only on rollback.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *deploymentStagesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	d.c = data.c
+	d.debug = data.debug
+}
+
+func (d *deploymentStagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state deploymentStagesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getReq := &api.GetDeploymentRequest{
+		DeploymentId: state.DeploymentID.ValueString(),
+	}
+	getResp, err := d.c.GetDeployment(ctx, getReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read PipeCD deployment",
+			errorDetail(err, d.debug),
+		)
+		return
+	}
+
+	stages, statuses, diags := stagesByIndex(path.Root("stages"), getResp.Deployment.GetStages())
+	resp.Diagnostics.Append(diags...)
+
+	state.Stages = make([]deploymentStageDataSourceModel, 0, len(stages))
+	for i, s := range stages {
+		state.Stages = append(state.Stages, deploymentStageDataSourceModel{
+			ID:               types.StringValue(s.Id),
+			Name:             types.StringValue(s.Name),
+			Description:      types.StringValue(s.Desc),
+			Index:            types.Int64Value(int64(s.Index)),
+			Status:           statuses[i],
+			StatusReason:     types.StringValue(s.StatusReason),
+			RequiresApproval: types.BoolValue(s.Name == string(model.StageWaitApproval)),
+			Rollback:         types.BoolValue(s.Rollback),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// stagesByIndex returns the given stages ordered by their index, together with their statuses
+// converted for the status attribute of the elements of the list attribute at p.
+func stagesByIndex(p path.Path, stages []*model.PipelineStage) ([]*model.PipelineStage, []types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	stages = append([]*model.PipelineStage(nil), stages...)
+	sort.SliceStable(stages, func(i, j int) bool {
+		return stages[i].Index < stages[j].Index
+	})
+
+	statuses := make([]types.String, 0, len(stages))
+	for i, s := range stages {
+		status, d := enumValue(p.AtListIndex(i).AtName("status"), s.Status)
+		diags.Append(d...)
+		statuses = append(statuses, status)
+	}
+	return stages, statuses, diags
+}
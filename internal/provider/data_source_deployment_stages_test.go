@@ -0,0 +1,133 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/model"
+	"github.com/pipe-cd/terraform-provider-pipecd/internal/provider/mock"
+)
+
+func TestAccDataSourceDeploymentStages(t *testing.T) {
+	t.Parallel()
+
+	const deploymentID = "test_deployment_id"
+
+	getReq := &apiservice.GetDeploymentRequest{DeploymentId: deploymentID}
+	getResp := &apiservice.GetDeploymentResponse{
+		Deployment: &model.Deployment{
+			Id: deploymentID,
+			// Stages are intentionally out of order to check sorting by index.
+			Stages: []*model.PipelineStage{
+				{
+					Id:     "stage-2",
+					Name:   "K8S_PRIMARY_ROLLOUT",
+					Index:  2,
+					Status: model.StageStatus_STAGE_NOT_STARTED_YET,
+				},
+				{
+					Id:     "stage-0",
+					Name:   "K8S_CANARY_ROLLOUT",
+					Desc:   "Rollout the canary variant",
+					Index:  0,
+					Status: model.StageStatus_STAGE_SUCCESS,
+				},
+				{
+					Id:           "stage-1",
+					Name:         "WAIT_APPROVAL",
+					Index:        1,
+					Status:       model.StageStatus_STAGE_RUNNING,
+					StatusReason: "waiting for approval",
+				},
+				{
+					Id:       "stage-rollback",
+					Name:     "ROLLBACK",
+					Index:    3,
+					Status:   model.StageStatus_STAGE_NOT_STARTED_YET,
+					Rollback: true,
+				},
+			},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	client.EXPECT().GetDeployment(gomock.Any(), protoEq(getReq)).Return(getResp, nil).AnyTimes()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDeploymentStages(deploymentID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pipecd_deployment_stages.test", "deployment_id", deploymentID),
+					resource.TestCheckResourceAttr("data.pipecd_deployment_stages.test", "stages.#", "4"),
+					resource.TestCheckResourceAttr("data.pipecd_deployment_stages.test", "stages.0.id", "stage-0"),
+					resource.TestCheckResourceAttr("data.pipecd_deployment_stages.test", "stages.0.description", "Rollout the canary variant"),
+					resource.TestCheckResourceAttr("data.pipecd_deployment_stages.test", "stages.0.status", "STAGE_SUCCESS"),
+					resource.TestCheckResourceAttr("data.pipecd_deployment_stages.test", "stages.0.requires_approval", "false"),
+					resource.TestCheckResourceAttr("data.pipecd_deployment_stages.test", "stages.1.name", "WAIT_APPROVAL"),
+					resource.TestCheckResourceAttr("data.pipecd_deployment_stages.test", "stages.1.status", "STAGE_RUNNING"),
+					resource.TestCheckResourceAttr("data.pipecd_deployment_stages.test", "stages.1.status_reason", "waiting for approval"),
+					resource.TestCheckResourceAttr("data.pipecd_deployment_stages.test", "stages.1.requires_approval", "true"),
+					resource.TestCheckResourceAttr("data.pipecd_deployment_stages.test", "stages.2.id", "stage-2"),
+					resource.TestCheckResourceAttr("data.pipecd_deployment_stages.test", "stages.3.rollback", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceDeploymentStagesWithoutStages(t *testing.T) {
+	t.Parallel()
+
+	const deploymentID = "test_deployment_id"
+
+	getReq := &apiservice.GetDeploymentRequest{DeploymentId: deploymentID}
+	getResp := &apiservice.GetDeploymentResponse{
+		Deployment: &model.Deployment{
+			Id: deploymentID,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	client.EXPECT().GetDeployment(gomock.Any(), protoEq(getReq)).Return(getResp, nil).AnyTimes()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDeploymentStages(deploymentID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pipecd_deployment_stages.test", "stages.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceDeploymentStages(deploymentID string) string {
+	return providerConfig + fmt.Sprintf(`
+data "pipecd_deployment_stages" "test" {
+	deployment_id = "%s"
+}`, deploymentID)
+}
@@ -0,0 +1,135 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/model"
+	"github.com/pipe-cd/terraform-provider-pipecd/internal/provider/mock"
+)
+
+func TestAccDataSourceDeployment(t *testing.T) {
+	t.Parallel()
+
+	const deploymentID = "test_deployment_id"
+
+	getReq := &apiservice.GetDeploymentRequest{DeploymentId: deploymentID}
+	getResp := &apiservice.GetDeploymentResponse{
+		Deployment: &model.Deployment{
+			Id:            deploymentID,
+			ApplicationId: "test_application_id",
+			Status:        model.DeploymentStatus_DEPLOYMENT_SUCCESS,
+			// Stages are intentionally out of order to check sorting by index.
+			Stages: []*model.PipelineStage{
+				{
+					Id:     "stage-1",
+					Name:   "K8S_PRIMARY_ROLLOUT",
+					Index:  1,
+					Status: model.StageStatus_STAGE_SUCCESS,
+				},
+				{
+					Id:     "stage-0",
+					Name:   "K8S_CANARY_ROLLOUT",
+					Index:  0,
+					Status: model.StageStatus_STAGE_SUCCESS,
+				},
+			},
+			Trigger: &model.DeploymentTrigger{
+				Commit: &model.Commit{
+					Hash:    "0123456789abcdef",
+					Message: "Update image",
+					Branch:  "main",
+				},
+			},
+			Version:     "v0.1.0",
+			CreatedAt:   1700000000,
+			CompletedAt: 1700000300,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	client.EXPECT().GetDeployment(gomock.Any(), protoEq(getReq)).Return(getResp, nil).AnyTimes()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDeployment(deploymentID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pipecd_deployment.test", "id", deploymentID),
+					resource.TestCheckResourceAttr("data.pipecd_deployment.test", "application_id", "test_application_id"),
+					resource.TestCheckResourceAttr("data.pipecd_deployment.test", "status", "DEPLOYMENT_SUCCESS"),
+					resource.TestCheckResourceAttr("data.pipecd_deployment.test", "stage_statuses.#", "2"),
+					resource.TestCheckResourceAttr("data.pipecd_deployment.test", "stage_statuses.0.id", "stage-0"),
+					resource.TestCheckResourceAttr("data.pipecd_deployment.test", "stage_statuses.0.status", "STAGE_SUCCESS"),
+					resource.TestCheckResourceAttr("data.pipecd_deployment.test", "stage_statuses.1.name", "K8S_PRIMARY_ROLLOUT"),
+					resource.TestCheckResourceAttr("data.pipecd_deployment.test", "trigger.commit.hash", "0123456789abcdef"),
+					resource.TestCheckResourceAttr("data.pipecd_deployment.test", "trigger.commit.branch", "main"),
+					resource.TestCheckResourceAttr("data.pipecd_deployment.test", "version", "v0.1.0"),
+					resource.TestCheckResourceAttr("data.pipecd_deployment.test", "created_at", "2023-11-14T22:13:20Z"),
+					resource.TestCheckResourceAttr("data.pipecd_deployment.test", "completed_at", "2023-11-14T22:18:20Z"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceDeploymentRunning(t *testing.T) {
+	t.Parallel()
+
+	const deploymentID = "test_deployment_id"
+
+	getReq := &apiservice.GetDeploymentRequest{DeploymentId: deploymentID}
+	getResp := &apiservice.GetDeploymentResponse{
+		Deployment: &model.Deployment{
+			Id:        deploymentID,
+			Status:    model.DeploymentStatus_DEPLOYMENT_RUNNING,
+			CreatedAt: 1700000000,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	client.EXPECT().GetDeployment(gomock.Any(), protoEq(getReq)).Return(getResp, nil).AnyTimes()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDeployment(deploymentID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pipecd_deployment.test", "status", "DEPLOYMENT_RUNNING"),
+					resource.TestCheckResourceAttr("data.pipecd_deployment.test", "stage_statuses.#", "0"),
+					resource.TestCheckNoResourceAttr("data.pipecd_deployment.test", "trigger"),
+					resource.TestCheckNoResourceAttr("data.pipecd_deployment.test", "completed_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceDeployment(id string) string {
+	return providerConfig + fmt.Sprintf(`
+data "pipecd_deployment" "test" {
+	id = "%s"
+}`, id)
+}
@@ -0,0 +1,231 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/protobuf/proto"
+
+	api "github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+var (
+	_ datasource.DataSource              = &deploymentsDataSource{}
+	_ datasource.DataSourceWithConfigure = &deploymentsDataSource{}
+)
+
+func NewDeploymentsDataSource() datasource.DataSource {
+	return &deploymentsDataSource{}
+}
+
+type deploymentsDataSource struct {
+	c     APIClient
+	debug bool
+}
+
+type (
+	deploymentsDataSourceModel struct {
+		ApplicationID types.String             `tfsdk:"application_id"`
+		Kind          types.String             `tfsdk:"kind"`
+		Statuses      []types.String           `tfsdk:"statuses"`
+		Limit         types.Int64              `tfsdk:"limit"`
+		Deployments   []deploymentSummaryModel `tfsdk:"deployments"`
+	}
+
+	deploymentSummaryModel struct {
+		ID            types.String            `tfsdk:"id"`
+		ApplicationID types.String            `tfsdk:"application_id"`
+		Status        types.String            `tfsdk:"status"`
+		CreatedAt     types.String            `tfsdk:"created_at"`
+		Trigger       *deploymentTriggerModel `tfsdk:"trigger"`
+	}
+)
+
+func (d *deploymentsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployments"
+}
+
+func (d *deploymentsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "PipeCD deployments data source. Lists the deployments of the project matching all the given filters, most recently updated first.",
+
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Description: "Only list the deployments of this application.",
+				Optional:    true,
+			},
+			"kind": schema.StringAttribute{
+				Description: "Only list the deployments of applications of this kind.",
+				Optional:    true,
+				Validators: []validator.String{
+					func() validator.String {
+						values := make([]string, 0, len(model.ApplicationKind_value))
+						for k := range model.ApplicationKind_value {
+							values = append(values, k)
+						}
+						return stringvalidator.OneOf(values...)
+					}(),
+				},
+			},
+			"statuses": schema.ListAttribute{
+				MarkdownDescription: "Only list the deployments in one of these statuses, e.g. `SUCCESS`. " +
+					"The names of the statuses in PipeCD, e.g. `DEPLOYMENT_SUCCESS`, are also accepted.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(deploymentStatusValidator{}),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				Description: "The maximum number of deployments to list. All matching deployments are listed when unset.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"deployments": schema.ListNestedAttribute{
+				Description: "The matching deployments. Empty when no deployment matches.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"application_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The status of the deployment, e.g. `DEPLOYMENT_SUCCESS`.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "When the deployment was created, in RFC 3339 format.",
+							Computed:            true,
+						},
+						"trigger": deploymentTriggerAttribute(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *deploymentsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	d.c = data.c
+	d.debug = data.debug
+}
+
+func (d *deploymentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state deploymentsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listReq := &api.ListDeploymentsRequest{
+		Limit: int32(state.Limit.ValueInt64()),
+	}
+	if !state.ApplicationID.IsNull() {
+		listReq.ApplicationIds = []string{state.ApplicationID.ValueString()}
+	}
+	if !state.Kind.IsNull() {
+		listReq.Kinds = []string{state.Kind.ValueString()}
+	}
+	// PipeCD only filters by the first of the given statuses, so more of them are filtered here.
+	statuses := make(map[model.DeploymentStatus]bool, len(state.Statuses))
+	for _, s := range state.Statuses {
+		// The statuses were already validated.
+		status, _ := DeploymentStatusFromString(s.ValueString())
+		statuses[status] = true
+	}
+	if len(statuses) == 1 {
+		for s := range statuses {
+			listReq.Statuses = []string{s.String()}
+		}
+	}
+	match := func(d *model.Deployment) bool {
+		return len(statuses) == 0 || statuses[d.Status]
+	}
+
+	deployments, err := listDeployments(ctx, d.c, listReq, int(state.Limit.ValueInt64()), match)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to List PipeCD deployments",
+			errorDetail(err, d.debug),
+		)
+		return
+	}
+
+	state.Deployments = make([]deploymentSummaryModel, 0, len(deployments))
+	for i, deployment := range deployments {
+		status, diags := enumValue(path.Root("deployments").AtListIndex(i).AtName("status"), deployment.Status)
+		resp.Diagnostics.Append(diags...)
+		state.Deployments = append(state.Deployments, deploymentSummaryModel{
+			ID:            types.StringValue(deployment.Id),
+			ApplicationID: types.StringValue(deployment.ApplicationId),
+			Status:        status,
+			CreatedAt:     timestampValue(deployment.CreatedAt),
+			Trigger:       newDeploymentTriggerModel(deployment.Trigger),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// listDeployments lists the deployments matching req and match page by page, until limit of them are found.
+// A limit of zero lists all of them.
+func listDeployments(
+	ctx context.Context, c APIClient, req *api.ListDeploymentsRequest, limit int, match func(*model.Deployment) bool,
+) ([]*model.Deployment, error) {
+	var deployments []*model.Deployment
+	for {
+		listResp, err := c.ListDeployments(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range listResp.Deployments {
+			if !match(d) {
+				continue
+			}
+			deployments = append(deployments, d)
+			if limit > 0 && len(deployments) == limit {
+				return deployments, nil
+			}
+		}
+		// The cursor is also returned with the last page, which is followed by an empty one.
+		if listResp.Cursor == "" || len(listResp.Deployments) == 0 {
+			return deployments, nil
+		}
+		req = proto.Clone(req).(*api.ListDeploymentsRequest)
+		req.Cursor = listResp.Cursor
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/model"
+	"github.com/pipe-cd/terraform-provider-pipecd/internal/provider/mock"
+)
+
+func TestAccDataSourceDeployments(t *testing.T) {
+	t.Parallel()
+
+	const appID = "test_application_id"
+
+	firstReq := &apiservice.ListDeploymentsRequest{
+		ApplicationIds: []string{appID},
+		Statuses:       []string{"DEPLOYMENT_SUCCESS"},
+		Limit:          3,
+	}
+	firstResp := &apiservice.ListDeploymentsResponse{
+		Deployments: []*model.Deployment{
+			{
+				Id:            "deployment-0",
+				ApplicationId: appID,
+				Status:        model.DeploymentStatus_DEPLOYMENT_SUCCESS,
+				Trigger: &model.DeploymentTrigger{
+					Commit: &model.Commit{Hash: "0123456789abcdef", Branch: "main"},
+				},
+				CreatedAt: 1700000000,
+			},
+			{
+				Id:            "deployment-1",
+				ApplicationId: appID,
+				Status:        model.DeploymentStatus_DEPLOYMENT_SUCCESS,
+				CreatedAt:     1699999000,
+			},
+		},
+		Cursor: "next",
+	}
+	secondReq := &apiservice.ListDeploymentsRequest{
+		ApplicationIds: []string{appID},
+		Statuses:       []string{"DEPLOYMENT_SUCCESS"},
+		Limit:          3,
+		Cursor:         "next",
+	}
+	secondResp := &apiservice.ListDeploymentsResponse{
+		Deployments: []*model.Deployment{
+			{Id: "deployment-2", ApplicationId: appID, Status: model.DeploymentStatus_DEPLOYMENT_SUCCESS},
+			{Id: "deployment-3", ApplicationId: appID, Status: model.DeploymentStatus_DEPLOYMENT_SUCCESS},
+		},
+		Cursor: "last",
+	}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	client.EXPECT().ListDeployments(gomock.Any(), protoEq(firstReq)).Return(firstResp, nil).MinTimes(1)
+	client.EXPECT().ListDeployments(gomock.Any(), protoEq(secondReq)).Return(secondResp, nil).MinTimes(1)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+data "pipecd_deployments" "test" {
+	application_id = "%s"
+	statuses       = ["SUCCESS"]
+	limit          = 3
+}`, appID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pipecd_deployments.test", "deployments.#", "3"),
+					resource.TestCheckResourceAttr("data.pipecd_deployments.test", "deployments.0.id", "deployment-0"),
+					resource.TestCheckResourceAttr("data.pipecd_deployments.test", "deployments.0.status", "DEPLOYMENT_SUCCESS"),
+					resource.TestCheckResourceAttr("data.pipecd_deployments.test", "deployments.0.created_at", "2023-11-14T22:13:20Z"),
+					resource.TestCheckResourceAttr("data.pipecd_deployments.test", "deployments.0.trigger.commit.hash", "0123456789abcdef"),
+					resource.TestCheckNoResourceAttr("data.pipecd_deployments.test", "deployments.1.trigger"),
+					resource.TestCheckResourceAttr("data.pipecd_deployments.test", "deployments.2.id", "deployment-2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceDeploymentsStatuses(t *testing.T) {
+	t.Parallel()
+
+	listResp := &apiservice.ListDeploymentsResponse{
+		Deployments: []*model.Deployment{
+			{Id: "deployment-0", Status: model.DeploymentStatus_DEPLOYMENT_RUNNING},
+			{Id: "deployment-1", Status: model.DeploymentStatus_DEPLOYMENT_SUCCESS},
+			{Id: "deployment-2", Status: model.DeploymentStatus_DEPLOYMENT_FAILURE},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	// Several statuses are filtered by the provider, as PipeCD only uses the first one.
+	client.EXPECT().ListDeployments(gomock.Any(), protoEq(&apiservice.ListDeploymentsRequest{})).Return(listResp, nil).MinTimes(1)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "pipecd_deployments" "test" {
+	statuses = ["SUCCESS", "DEPLOYMENT_FAILURE"]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pipecd_deployments.test", "deployments.#", "2"),
+					resource.TestCheckResourceAttr("data.pipecd_deployments.test", "deployments.0.id", "deployment-1"),
+					resource.TestCheckResourceAttr("data.pipecd_deployments.test", "deployments.1.id", "deployment-2"),
+				),
+			},
+		},
+	})
+}
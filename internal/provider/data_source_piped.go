@@ -16,6 +16,7 @@ package provider
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -34,7 +35,8 @@ func NewPipedDataSource() datasource.DataSource {
 }
 
 type pipedDataSource struct {
-	c APIClient
+	c     APIClient
+	debug bool
 }
 
 type (
@@ -45,6 +47,8 @@ type (
 		ProjectID         types.String                           `tfsdk:"project_id"`
 		Repositories      []pipedDataSourceRepositoryModel       `tfsdk:"repositories"`
 		PlatformProviders []pipedDataSourcePlatformProviderModel `tfsdk:"platform_providers"`
+		SecretEncryption  *pipedDataSourceSecretEncryptionModel  `tfsdk:"secret_encryption"`
+		Keys              []pipedDataSourceKeyModel              `tfsdk:"keys"`
 	}
 
 	pipedDataSourceRepositoryModel struct {
@@ -57,6 +61,16 @@ type (
 		Name types.String `tfsdk:"name"`
 		Type types.String `tfsdk:"type"`
 	}
+
+	pipedDataSourceKeyModel struct {
+		Creator   types.String `tfsdk:"creator"`
+		CreatedAt types.String `tfsdk:"created_at"`
+	}
+
+	pipedDataSourceSecretEncryptionModel struct {
+		Type   types.String `tfsdk:"type"`
+		HasKey types.Bool   `tfsdk:"has_key"`
+	}
 )
 
 func (p *pipedDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -81,7 +95,8 @@ func (p *pipedDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Computed: true,
 			},
 			"repositories": schema.ListNestedAttribute{
-				Computed: true,
+				MarkdownDescription: "The repositories of the piped. Always a list, empty when the piped has no repositories.",
+				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"id": schema.StringAttribute{
@@ -97,7 +112,8 @@ func (p *pipedDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				},
 			},
 			"platform_providers": schema.ListNestedAttribute{
-				Computed: true,
+				MarkdownDescription: "The platform providers of the piped. Always a list, empty when the piped has no platform providers.",
+				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"name": schema.StringAttribute{
@@ -109,6 +125,37 @@ func (p *pipedDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 					},
 				},
 			},
+			"keys": schema.ListNestedAttribute{
+				MarkdownDescription: "The metadata of the keys the piped can authenticate with, e.g. to audit key rotation. " +
+					"The keys themselves are never exposed.",
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"creator": schema.StringAttribute{
+							MarkdownDescription: "The user who created the key.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "The time the key was created, in RFC 3339 format.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"secret_encryption": schema.SingleNestedAttribute{
+				MarkdownDescription: "The secret encryption settings of the piped. The key itself is never exposed.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The secret management type, e.g. `KEY_PAIR`. Empty when the piped has no secret management configured.",
+						Computed:            true,
+					},
+					"has_key": schema.BoolAttribute{
+						MarkdownDescription: "Whether the piped has a key to encrypt secrets with, i.e. a public key or a service account.",
+						Computed:            true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -118,7 +165,9 @@ func (p *pipedDataSource) Configure(_ context.Context, req datasource.ConfigureR
 		return
 	}
 
-	p.c = req.ProviderData.(APIClient)
+	data := req.ProviderData.(*providerData)
+	p.c = data.c
+	p.debug = data.debug
 }
 
 func (p *pipedDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -136,7 +185,7 @@ func (p *pipedDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read PipeCD piped",
-			err.Error(),
+			errorDetail(err, p.debug),
 		)
 		return
 	}
@@ -158,6 +207,16 @@ func (p *pipedDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		})
 	}
 
+	// Only the metadata of the keys is read. PipeCD redacts their hashes anyway.
+	keys := make([]pipedDataSourceKeyModel, 0, len(getResp.Piped.Keys))
+	for _, k := range getResp.Piped.Keys {
+		keys = append(keys, pipedDataSourceKeyModel{
+			Creator:   types.StringValue(k.Creator),
+			CreatedAt: types.StringValue(time.Unix(k.CreatedAt, 0).UTC().Format(time.RFC3339)),
+		})
+	}
+
+	secret := getResp.Piped.GetSecretEncryption()
 	state = pipedDataSourceModel{
 		ID:                types.StringValue(getResp.Piped.Id),
 		Name:              types.StringValue(getResp.Piped.Name),
@@ -165,6 +224,11 @@ func (p *pipedDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		Description:       types.StringValue(getResp.Piped.Desc),
 		Repositories:      repos,
 		PlatformProviders: providers,
+		Keys:              keys,
+		SecretEncryption: &pipedDataSourceSecretEncryptionModel{
+			Type:   types.StringValue(secret.GetType()),
+			HasKey: types.BoolValue(secret.GetPublicKey() != "" || secret.GetEncryptServiceAccount() != ""),
+		},
 	}
 
 	diags = resp.State.Set(ctx, &state)
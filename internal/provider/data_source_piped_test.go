@@ -16,10 +16,12 @@ package provider
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
 	"github.com/pipe-cd/pipecd/pkg/model"
@@ -51,12 +53,28 @@ func TestAccDataSourcePiped(t *testing.T) {
 					Type: "test_provider_type",
 				},
 			},
+			SecretEncryption: &model.Piped_SecretEncryption{
+				Type:      "KEY_PAIR",
+				PublicKey: "test_public_key",
+			},
+			Keys: []*model.PipedKey{
+				{
+					Hash:      "test_key_hash",
+					Creator:   "alice",
+					CreatedAt: 1700000000,
+				},
+				{
+					Hash:      "test_rotated_key_hash",
+					Creator:   "bob",
+					CreatedAt: 1710000000,
+				},
+			},
 		},
 	}
 
 	ctrl := gomock.NewController(t)
 	client := mock.NewMockAPIClient(ctrl)
-	client.EXPECT().GetPiped(gomock.Any(), getReq).Return(getResp, nil).AnyTimes()
+	client.EXPECT().GetPiped(gomock.Any(), protoEq(getReq)).Return(getResp, nil).AnyTimes()
 
 	resource.UnitTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
@@ -75,6 +93,60 @@ func TestAccDataSourcePiped(t *testing.T) {
 					resource.TestCheckResourceAttr("data.pipecd_piped.test", "platform_providers.#", "1"),
 					resource.TestCheckResourceAttr("data.pipecd_piped.test", "platform_providers.0.name", "test_provider_name"),
 					resource.TestCheckResourceAttr("data.pipecd_piped.test", "platform_providers.0.type", "test_provider_type"),
+					resource.TestCheckResourceAttr("data.pipecd_piped.test", "secret_encryption.type", "KEY_PAIR"),
+					resource.TestCheckResourceAttr("data.pipecd_piped.test", "secret_encryption.has_key", "true"),
+					resource.TestCheckResourceAttr("data.pipecd_piped.test", "keys.#", "2"),
+					resource.TestCheckResourceAttr("data.pipecd_piped.test", "keys.0.creator", "alice"),
+					resource.TestCheckResourceAttr("data.pipecd_piped.test", "keys.0.created_at", "2023-11-14T22:13:20Z"),
+					resource.TestCheckResourceAttr("data.pipecd_piped.test", "keys.1.creator", "bob"),
+					resource.TestCheckResourceAttr("data.pipecd_piped.test", "keys.1.created_at", "2024-03-09T16:00:00Z"),
+					func(s *terraform.State) error {
+						for k, v := range s.RootModule().Resources["data.pipecd_piped.test"].Primary.Attributes {
+							if strings.Contains(v, "key_hash") {
+								return fmt.Errorf("expected no key hash in state, got %s = %q", k, v)
+							}
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourcePipedWithoutRepositoriesAndPlatformProviders(t *testing.T) {
+	t.Parallel()
+
+	const pipedID = "test_piped_id"
+
+	getReq := &apiservice.GetPipedRequest{PipedId: pipedID}
+	getResp := &apiservice.GetPipedResponse{
+		Piped: &model.Piped{
+			Id:        pipedID,
+			Name:      "test_name",
+			ProjectId: "test_project",
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	client.EXPECT().GetPiped(gomock.Any(), protoEq(getReq)).Return(getResp, nil).AnyTimes()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				// Empty lists rather than null ones, so that length() works on them.
+				Config: testAccDataSourcePiped(pipedID) + `
+output "platform_providers_count" {
+	value = length(data.pipecd_piped.test.platform_providers)
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pipecd_piped.test", "repositories.#", "0"),
+					resource.TestCheckResourceAttr("data.pipecd_piped.test", "platform_providers.#", "0"),
+					resource.TestCheckResourceAttr("data.pipecd_piped.test", "secret_encryption.has_key", "false"),
+					resource.TestCheckResourceAttr("data.pipecd_piped.test", "keys.#", "0"),
+					resource.TestCheckOutput("platform_providers_count", "0"),
 				),
 			},
 		},
@@ -0,0 +1,278 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	api "github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+var (
+	_ datasource.DataSource              = &planPreviewDataSource{}
+	_ datasource.DataSourceWithConfigure = &planPreviewDataSource{}
+)
+
+const (
+	defaultPlanPreviewTimeout = "5m"
+	planPreviewPollInterval   = 10 * time.Second
+)
+
+func NewPlanPreviewDataSource() datasource.DataSource {
+	return &planPreviewDataSource{}
+}
+
+type planPreviewDataSource struct {
+	c     APIClient
+	debug bool
+}
+
+type (
+	planPreviewDataSourceModel struct {
+		RepoRemoteURL types.String                    `tfsdk:"repo_remote_url"`
+		HeadBranch    types.String                    `tfsdk:"head_branch"`
+		HeadCommit    types.String                    `tfsdk:"head_commit"`
+		BaseBranch    types.String                    `tfsdk:"base_branch"`
+		Timeout       types.String                    `tfsdk:"timeout"`
+		Results       []planPreviewCommandResultModel `tfsdk:"results"`
+	}
+
+	planPreviewCommandResultModel struct {
+		CommandID    types.String                        `tfsdk:"command_id"`
+		PipedID      types.String                        `tfsdk:"piped_id"`
+		PipedName    types.String                        `tfsdk:"piped_name"`
+		Error        types.String                        `tfsdk:"error"`
+		Applications []planPreviewApplicationResultModel `tfsdk:"applications"`
+	}
+
+	planPreviewApplicationResultModel struct {
+		ApplicationID   types.String `tfsdk:"application_id"`
+		ApplicationName types.String `tfsdk:"application_name"`
+		ApplicationKind types.String `tfsdk:"application_kind"`
+		SyncStrategy    types.String `tfsdk:"sync_strategy"`
+		NoChange        types.Bool   `tfsdk:"no_change"`
+		PlanSummary     types.String `tfsdk:"plan_summary"`
+		PlanDetails     types.String `tfsdk:"plan_details"`
+		Error           types.String `tfsdk:"error"`
+	}
+)
+
+func (d *planPreviewDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_plan_preview"
+}
+
+func (d *planPreviewDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "PipeCD plan preview data source. Reading it requests a plan preview of the applications " +
+			"affected by the changes between the head and the base branches, and waits for its results.\n\n" +
+			"~> **Note:** Reading this data source is not free of side effects. Every read, including the ones of " +
+			"`terraform plan` and `terraform refresh`, requests a new plan preview, which queues a plan preview command " +
+			"on the pipeds handling the affected applications. Only declare it in configurations meant to preview changes, " +
+			"e.g. the ones run in CI for pull requests.",
+
+		Attributes: map[string]schema.Attribute{
+			"repo_remote_url": schema.StringAttribute{
+				MarkdownDescription: "The remote URL of the Git repository.",
+				Required:            true,
+			},
+			"head_branch": schema.StringAttribute{
+				MarkdownDescription: "The branch containing the changes.",
+				Required:            true,
+			},
+			"head_commit": schema.StringAttribute{
+				MarkdownDescription: "The commit hash of the head branch.",
+				Required:            true,
+			},
+			"base_branch": schema.StringAttribute{
+				MarkdownDescription: "The branch the changes are compared against.",
+				Required:            true,
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to wait for the results. (default \"" + defaultPlanPreviewTimeout + "\")",
+				Optional:            true,
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"results": schema.ListNestedAttribute{
+				MarkdownDescription: "The results reported by each piped handling the plan preview.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"command_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"piped_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"piped_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"error": schema.StringAttribute{
+							MarkdownDescription: "The error that prevented the piped from building the plan preview, if any.",
+							Computed:            true,
+						},
+						"applications": schema.ListNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"application_id": schema.StringAttribute{
+										Computed: true,
+									},
+									"application_name": schema.StringAttribute{
+										Computed: true,
+									},
+									"application_kind": schema.StringAttribute{
+										Computed: true,
+									},
+									"sync_strategy": schema.StringAttribute{
+										Computed: true,
+									},
+									"no_change": schema.BoolAttribute{
+										Computed: true,
+									},
+									"plan_summary": schema.StringAttribute{
+										Computed: true,
+									},
+									"plan_details": schema.StringAttribute{
+										Computed: true,
+									},
+									"error": schema.StringAttribute{
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *planPreviewDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	d.c = data.c
+	d.debug = data.debug
+}
+
+func (d *planPreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state planPreviewDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeoutValue := defaultPlanPreviewTimeout
+	if !state.Timeout.IsNull() {
+		timeoutValue = state.Timeout.ValueString()
+	}
+	timeout, _ := time.ParseDuration(timeoutValue)
+
+	requestResp, err := d.c.RequestPlanPreview(ctx, &api.RequestPlanPreviewRequest{
+		RepoRemoteUrl: state.RepoRemoteURL.ValueString(),
+		HeadBranch:    state.HeadBranch.ValueString(),
+		HeadCommit:    state.HeadCommit.ValueString(),
+		BaseBranch:    state.BaseBranch.ValueString(),
+		Timeout:       int64(timeout.Seconds()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Request PipeCD plan preview",
+			errorDetail(err, d.debug),
+		)
+		return
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	results, err := waitForPlanPreviewResults(waitCtx, d.c, requestResp.Commands, timeout, planPreviewPollInterval)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for PipeCD plan preview results",
+			"The plan preview was requested but its results were not available within "+timeout.String()+": "+errorDetail(err, d.debug),
+		)
+		return
+	}
+
+	state.Results = make([]planPreviewCommandResultModel, 0, len(results))
+	for i, r := range results {
+		apps := make([]planPreviewApplicationResultModel, 0, len(r.Results))
+		for j, a := range r.Results {
+			p := path.Root("results").AtListIndex(i).AtName("applications").AtListIndex(j)
+			kind, diags := enumValue(p.AtName("application_kind"), a.ApplicationKind)
+			resp.Diagnostics.Append(diags...)
+			syncStrategy, diags := enumValue(p.AtName("sync_strategy"), a.SyncStrategy)
+			resp.Diagnostics.Append(diags...)
+			apps = append(apps, planPreviewApplicationResultModel{
+				ApplicationID:   types.StringValue(a.ApplicationId),
+				ApplicationName: types.StringValue(a.ApplicationName),
+				ApplicationKind: kind,
+				SyncStrategy:    syncStrategy,
+				NoChange:        types.BoolValue(a.NoChange),
+				PlanSummary:     types.StringValue(string(a.PlanSummary)),
+				PlanDetails:     types.StringValue(string(a.PlanDetails)),
+				Error:           types.StringValue(a.Error),
+			})
+		}
+		state.Results = append(state.Results, planPreviewCommandResultModel{
+			CommandID:    types.StringValue(r.CommandId),
+			PipedID:      types.StringValue(r.PipedId),
+			PipedName:    types.StringValue(r.PipedName),
+			Error:        types.StringValue(r.Error),
+			Applications: apps,
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// waitForPlanPreviewResults polls the results of the given plan preview commands every interval until all of them are handled.
+// PipeCD reports NotFound while some of the commands are not handled yet.
+func waitForPlanPreviewResults(
+	ctx context.Context, c APIClient, commands []string, timeout, interval time.Duration,
+) ([]*model.PlanPreviewCommandResult, error) {
+	var results []*model.PlanPreviewCommandResult
+	err := poll(ctx, interval, func(ctx context.Context) (bool, error) {
+		getResp, err := c.GetPlanPreviewResults(ctx, &api.GetPlanPreviewResultsRequest{
+			Commands:             commands,
+			CommandHandleTimeout: int64(timeout.Seconds()),
+		})
+		if isNotFound(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		results = getResp.Results
+		return true, nil
+	})
+	return results, err
+}
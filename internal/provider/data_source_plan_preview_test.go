@@ -0,0 +1,132 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/model"
+	"github.com/pipe-cd/terraform-provider-pipecd/internal/provider/mock"
+)
+
+func TestAccDataSourcePlanPreview(t *testing.T) {
+	t.Parallel()
+
+	requestReq := &apiservice.RequestPlanPreviewRequest{
+		RepoRemoteUrl: "git@github.com:pipe-cd/examples.git",
+		HeadBranch:    "feature",
+		HeadCommit:    "0123456789",
+		BaseBranch:    "master",
+		Timeout:       60,
+	}
+	requestResp := &apiservice.RequestPlanPreviewResponse{Commands: []string{"command_id"}}
+
+	getReq := &apiservice.GetPlanPreviewResultsRequest{
+		Commands:             []string{"command_id"},
+		CommandHandleTimeout: 60,
+	}
+	getResp := &apiservice.GetPlanPreviewResultsResponse{
+		Results: []*model.PlanPreviewCommandResult{
+			{
+				CommandId: "command_id",
+				PipedId:   "piped_id",
+				PipedName: "piped_name",
+				Results: []*model.ApplicationPlanPreviewResult{
+					{
+						ApplicationId:   "app_id",
+						ApplicationName: "app_name",
+						ApplicationKind: model.ApplicationKind_KUBERNETES,
+						SyncStrategy:    model.SyncStrategy_PIPELINE,
+						PlanSummary:     []byte("1 changed"),
+						PlanDetails:     []byte("- replicas: 1\n+ replicas: 2"),
+					},
+				},
+			},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	client.EXPECT().RequestPlanPreview(gomock.Any(), protoEq(requestReq)).Return(requestResp, nil).AnyTimes()
+	client.EXPECT().GetPlanPreviewResults(gomock.Any(), protoEq(getReq)).Return(getResp, nil).AnyTimes()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "pipecd_plan_preview" "test" {
+	repo_remote_url = "git@github.com:pipe-cd/examples.git"
+	head_branch     = "feature"
+	head_commit     = "0123456789"
+	base_branch     = "master"
+	timeout         = "1m"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pipecd_plan_preview.test", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.pipecd_plan_preview.test", "results.0.piped_name", "piped_name"),
+					resource.TestCheckResourceAttr("data.pipecd_plan_preview.test", "results.0.error", ""),
+					resource.TestCheckResourceAttr("data.pipecd_plan_preview.test", "results.0.applications.#", "1"),
+					resource.TestCheckResourceAttr("data.pipecd_plan_preview.test", "results.0.applications.0.application_id", "app_id"),
+					resource.TestCheckResourceAttr("data.pipecd_plan_preview.test", "results.0.applications.0.application_kind", "KUBERNETES"),
+					resource.TestCheckResourceAttr("data.pipecd_plan_preview.test", "results.0.applications.0.sync_strategy", "PIPELINE"),
+					resource.TestCheckResourceAttr("data.pipecd_plan_preview.test", "results.0.applications.0.no_change", "false"),
+					resource.TestCheckResourceAttr("data.pipecd_plan_preview.test", "results.0.applications.0.plan_summary", "1 changed"),
+				),
+			},
+		},
+	})
+}
+
+func TestWaitForPlanPreviewResults(t *testing.T) {
+	t.Parallel()
+
+	getReq := &apiservice.GetPlanPreviewResultsRequest{
+		Commands:             []string{"command_id"},
+		CommandHandleTimeout: 300,
+	}
+	getResp := &apiservice.GetPlanPreviewResultsResponse{
+		Results: []*model.PlanPreviewCommandResult{
+			{CommandId: "command_id", PipedId: "piped_id"},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	gomock.InOrder(
+		client.EXPECT().GetPlanPreviewResults(gomock.Any(), protoEq(getReq)).Return(nil, status.Error(codes.NotFound, "command is not handled yet")),
+		client.EXPECT().GetPlanPreviewResults(gomock.Any(), protoEq(getReq)).Return(getResp, nil),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	results, err := waitForPlanPreviewResults(ctx, client, []string{"command_id"}, 5*time.Minute, 10*time.Millisecond)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if len(results) != 1 || results[0].CommandId != "command_id" {
+		t.Errorf("unexpected results: %v", results)
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &providerConfigDataSource{}
+	_ datasource.DataSourceWithConfigure = &providerConfigDataSource{}
+)
+
+func NewProviderConfigDataSource() datasource.DataSource {
+	return &providerConfigDataSource{}
+}
+
+type providerConfigDataSource struct {
+	data *providerData
+}
+
+type providerConfigDataSourceModel struct {
+	Host              types.String `tfsdk:"host"`
+	APIKeySource      types.String `tfsdk:"api_key_source"`
+	TraceID           types.String `tfsdk:"trace_id"`
+	Strict            types.Bool   `tfsdk:"strict"`
+	Debug             types.Bool   `tfsdk:"debug"`
+	DefaultPipedID    types.String `tfsdk:"default_piped_id"`
+	TLSMode           types.String `tfsdk:"tls_mode"`
+	CACertFile        types.String `tfsdk:"ca_cert_file"`
+	DialTimeout       types.String `tfsdk:"dial_timeout"`
+	GRPCServiceConfig types.String `tfsdk:"grpc_service_config"`
+}
+
+func (d *providerConfigDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_provider_config"
+}
+
+func (d *providerConfigDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The effective configuration of the provider after resolving environment variables. " +
+			"The API key itself is never exposed.",
+
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				Computed: true,
+			},
+			"api_key_source": schema.StringAttribute{
+				MarkdownDescription: "Where the API key was taken from, either `config` or `env`.",
+				Computed:            true,
+			},
+			"trace_id": schema.StringAttribute{
+				MarkdownDescription: "The trace ID sent with every request to PipeCD.",
+				Computed:            true,
+			},
+			"strict": schema.BoolAttribute{
+				Computed: true,
+			},
+			"debug": schema.BoolAttribute{
+				Computed: true,
+			},
+			"default_piped_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"tls_mode": schema.StringAttribute{
+				MarkdownDescription: "How the connection to PipeCD is secured: `plaintext` when `insecure` is set, " +
+					"`tls_skip_verify` when `insecure_skip_verify` is set, and `tls` otherwise.",
+				Computed: true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "The PEM file the PipeCD server is verified with, or empty if the system CAs are used.",
+				Computed:            true,
+			},
+			"dial_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long the provider waits for the connection to PipeCD to be established, e.g. `30s`.",
+				Computed:            true,
+			},
+			"grpc_service_config": schema.StringAttribute{
+				MarkdownDescription: "The gRPC service config applied to the connection, with its retry policies and timeouts, or empty if none is set.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *providerConfigDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.data = req.ProviderData.(*providerData)
+}
+
+func (d *providerConfigDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	state := providerConfigDataSourceModel{
+		Host:              types.StringValue(d.data.host),
+		APIKeySource:      types.StringValue(d.data.apiKeySource),
+		TraceID:           types.StringValue(d.data.traceID),
+		Strict:            types.BoolValue(d.data.strict),
+		Debug:             types.BoolValue(d.data.debug),
+		DefaultPipedID:    types.StringValue(d.data.defaultPipedID),
+		TLSMode:           types.StringValue(d.data.tlsMode),
+		CACertFile:        types.StringValue(d.data.caCertFile),
+		DialTimeout:       types.StringValue(d.data.dialTimeout.String()),
+		GRPCServiceConfig: types.StringValue(d.data.grpcServiceConfig),
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
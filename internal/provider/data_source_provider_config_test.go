@@ -0,0 +1,100 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/pipe-cd/terraform-provider-pipecd/internal/provider/mock"
+)
+
+func TestAccDataSourceProviderConfig(t *testing.T) {
+	t.Setenv(traceIDEnvVar, "test-trace-id")
+	t.Setenv(debugEnvVar, "true")
+
+	caCertFile, _, err := writeTestCertificate(t.TempDir())
+	if err != nil {
+		t.Errorf("failed to write test certificate: %v", err)
+		return
+	}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "pipecd" {
+  host    = "localhost:8018"
+  api_key = "secret-api-key"
+  strict  = true
+
+  insecure            = true
+  dial_timeout        = "10s"
+  grpc_service_config = jsonencode({ methodConfig = [] })
+}
+
+data "pipecd_provider_config" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pipecd_provider_config.test", "host", "localhost:8018"),
+					resource.TestCheckResourceAttr("data.pipecd_provider_config.test", "api_key_source", "config"),
+					resource.TestCheckResourceAttr("data.pipecd_provider_config.test", "trace_id", "test-trace-id"),
+					resource.TestCheckResourceAttr("data.pipecd_provider_config.test", "strict", "true"),
+					resource.TestCheckResourceAttr("data.pipecd_provider_config.test", "debug", "true"),
+					resource.TestCheckResourceAttr("data.pipecd_provider_config.test", "default_piped_id", ""),
+					resource.TestCheckResourceAttr("data.pipecd_provider_config.test", "tls_mode", "plaintext"),
+					resource.TestCheckResourceAttr("data.pipecd_provider_config.test", "ca_cert_file", ""),
+					resource.TestCheckResourceAttr("data.pipecd_provider_config.test", "dial_timeout", "10s"),
+					resource.TestCheckResourceAttr("data.pipecd_provider_config.test", "grpc_service_config", `{"methodConfig":[]}`),
+					resource.TestCheckNoResourceAttr("data.pipecd_provider_config.test", "api_key"),
+					func(s *terraform.State) error {
+						rs := s.RootModule().Resources["data.pipecd_provider_config.test"]
+						for k, v := range rs.Primary.Attributes {
+							if v == "secret-api-key" {
+								return fmt.Errorf("the API key is exposed in %s", k)
+							}
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "pipecd" {
+  host         = "localhost:8018"
+  api_key      = "secret-api-key"
+  ca_cert_file = %q
+}
+
+data "pipecd_provider_config" "test" {}
+`, caCertFile),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pipecd_provider_config.test", "tls_mode", "tls"),
+					resource.TestCheckResourceAttr("data.pipecd_provider_config.test", "ca_cert_file", caCertFile),
+					resource.TestCheckResourceAttr("data.pipecd_provider_config.test", "dial_timeout", "30s"),
+					resource.TestCheckResourceAttr("data.pipecd_provider_config.test", "grpc_service_config", ""),
+				),
+			},
+		},
+	})
+}
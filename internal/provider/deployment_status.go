@@ -0,0 +1,50 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const deploymentStatusPrefix = "DEPLOYMENT_"
+
+// DeploymentStatusValues returns the user-friendly names of all deployment statuses,
+// e.g. "SUCCESS" for model.DeploymentStatus_DEPLOYMENT_SUCCESS.
+func DeploymentStatusValues() []string {
+	values := make([]string, 0, len(model.DeploymentStatus_value))
+	for k := range model.DeploymentStatus_value {
+		values = append(values, strings.TrimPrefix(k, deploymentStatusPrefix))
+	}
+	sort.Strings(values)
+	return values
+}
+
+// DeploymentStatusFromString converts a user-friendly status string into model.DeploymentStatus.
+// Both the short form ("SUCCESS") and the proto enum name ("DEPLOYMENT_SUCCESS") are accepted, case-insensitively.
+func DeploymentStatusFromString(s string) (model.DeploymentStatus, error) {
+	name := strings.ToUpper(strings.TrimSpace(s))
+	if !strings.HasPrefix(name, deploymentStatusPrefix) {
+		name = deploymentStatusPrefix + name
+	}
+	v, ok := model.DeploymentStatus_value[name]
+	if !ok {
+		return 0, fmt.Errorf("unknown deployment status %q, valid values are: %s", s, strings.Join(DeploymentStatusValues(), ", "))
+	}
+	return model.DeploymentStatus(v), nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestDeploymentStatusFromString(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		input    string
+		expected model.DeploymentStatus
+		wantErr  bool
+	}{
+		{
+			name:     "short form",
+			input:    "SUCCESS",
+			expected: model.DeploymentStatus_DEPLOYMENT_SUCCESS,
+		},
+		{
+			name:     "enum name",
+			input:    "DEPLOYMENT_ROLLING_BACK",
+			expected: model.DeploymentStatus_DEPLOYMENT_ROLLING_BACK,
+		},
+		{
+			name:     "lower case",
+			input:    "failure",
+			expected: model.DeploymentStatus_DEPLOYMENT_FAILURE,
+		},
+		{
+			name:    "unknown status",
+			input:   "DONE",
+			wantErr: true,
+		},
+		{
+			name:    "empty",
+			input:   "",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := DeploymentStatusFromString(tc.input)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("expected an error for %q", tc.input)
+					return
+				}
+				if !strings.Contains(err.Error(), "PENDING") || !strings.Contains(err.Error(), "CANCELLED") {
+					t.Errorf("error should list valid statuses, got %q", err.Error())
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if got != tc.expected {
+				t.Errorf("expected %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// enumValue converts an enum value returned by PipeCD into a string attribute at p.
+// Values unknown to this provider, e.g. added in a newer PipeCD, are kept as the number sent by the server,
+// which is all the wire format carries, with a warning so that they do not go unnoticed.
+func enumValue(p path.Path, e protoreflect.Enum) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v := e.Descriptor().Values().ByNumber(e.Number()); v != nil {
+		return types.StringValue(string(v.Name())), diags
+	}
+
+	raw := fmt.Sprint(int32(e.Number()))
+	diags.AddAttributeWarning(
+		p,
+		"Unknown enum value",
+		fmt.Sprintf("PipeCD returned the value %s for %s, which is not a known %s to this provider version. "+
+			"The value is kept as is. Upgrade the provider to have it converted into its name.",
+			raw, p, e.Descriptor().Name()),
+	)
+	return types.StringValue(raw), diags
+}
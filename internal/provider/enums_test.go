@@ -0,0 +1,62 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestEnumValue(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		value       model.ApplicationKind
+		expected    string
+		wantWarning bool
+	}{
+		{
+			name:     "known value",
+			value:    model.ApplicationKind_ECS,
+			expected: "ECS",
+		},
+		{
+			name:        "unknown value",
+			value:       model.ApplicationKind(42),
+			expected:    "42",
+			wantWarning: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := enumValue(path.Root("kind"), tc.value)
+			if got.ValueString() != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got.ValueString())
+			}
+			if diags.HasError() {
+				t.Errorf("unexpected errors: %v", diags)
+			}
+			if got := diags.WarningsCount() == 1; got != tc.wantWarning {
+				t.Errorf("expected warning to be %t, got %v", tc.wantWarning, diags)
+			}
+		})
+	}
+}
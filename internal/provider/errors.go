@@ -0,0 +1,121 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// sensitiveKeywords are the substrings of metadata keys whose values are redacted from error details.
+var sensitiveKeywords = []string{"key", "token", "secret", "password", "credential"}
+
+const redacted = "<redacted>"
+
+// isNotFound reports whether err is a gRPC error with the NotFound code.
+func isNotFound(err error) bool {
+	return status.Code(err) == codes.NotFound
+}
+
+// apiKeySourceHints are the hints added to Unauthenticated errors, keyed by the source of the API key.
+var apiKeySourceHints = map[string]string{
+	"config": "The API key was taken from the api_key attribute of the provider configuration.",
+	"env":    "The API key was taken from the " + apiKeyEnvVar + " environment variable.",
+}
+
+// withAPIKeySourceHint adds a hint on where the API key came from to err if it is a gRPC error with the Unauthenticated code,
+// so that users know where to fix a rejected key. The key itself is never included.
+func withAPIKeySourceHint(err error, source string) error {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.Unauthenticated {
+		return err
+	}
+	hint, ok := apiKeySourceHints[source]
+	if !ok {
+		return err
+	}
+
+	p := st.Proto()
+	p.Message += ". " + hint + " Make sure it is a valid, unrevoked API key of the project"
+	return status.FromProto(p).Err()
+}
+
+// errorDetail returns the message of err to be shown in diagnostics.
+// When debug is set, the details attached to the gRPC status are appended as JSON.
+func errorDetail(err error, debug bool) string {
+	msg := err.Error()
+	if !debug {
+		return msg
+	}
+
+	st, ok := status.FromError(err)
+	if !ok || len(st.Details()) == 0 {
+		return msg
+	}
+
+	var b strings.Builder
+	b.WriteString(msg)
+	b.WriteString("\n\nStatus details:")
+	for _, d := range st.Details() {
+		b.WriteString("\n")
+		m, ok := d.(proto.Message)
+		if !ok {
+			// The detail type is not linked into the provider binary.
+			b.WriteString("unknown detail: ")
+			if e, ok := d.(error); ok {
+				b.WriteString(e.Error())
+			}
+			continue
+		}
+		out, merr := protojson.Marshal(redactDetail(m))
+		if merr != nil {
+			b.WriteString("unmarshalable detail: " + merr.Error())
+			continue
+		}
+		b.WriteString(string(m.ProtoReflect().Descriptor().FullName()) + ": " + string(out))
+	}
+	return b.String()
+}
+
+// redactDetail returns a copy of the given status detail with the sensitive values redacted.
+func redactDetail(m proto.Message) proto.Message {
+	info, ok := m.(*errdetails.ErrorInfo)
+	if !ok {
+		return m
+	}
+
+	info = proto.Clone(info).(*errdetails.ErrorInfo)
+	for k := range info.Metadata {
+		if isSensitiveKey(k) {
+			info.Metadata[k] = redacted
+		}
+	}
+	return info
+}
+
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, w := range sensitiveKeywords {
+		if strings.Contains(key, w) {
+			return true
+		}
+	}
+	return false
+}
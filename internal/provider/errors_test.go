@@ -0,0 +1,109 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestErrorDetail(t *testing.T) {
+	t.Parallel()
+
+	st, err := status.New(codes.FailedPrecondition, "application is not ready").WithDetails(
+		&errdetails.ErrorInfo{
+			Reason: "PIPED_NOT_CONNECTED",
+			Domain: "pipecd.dev",
+			Metadata: map[string]string{
+				"piped_id":  "test_piped_id",
+				"api_key":   "secret-api-key",
+				"authToken": "secret-token",
+			},
+		},
+		&errdetails.RetryInfo{},
+	)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+
+	if got := errorDetail(st.Err(), false); got != st.Err().Error() {
+		t.Errorf("expected only the error message without debug, got %q", got)
+	}
+
+	got := errorDetail(st.Err(), true)
+	for _, want := range []string{"application is not ready", "PIPED_NOT_CONNECTED", "test_piped_id", "google.rpc.RetryInfo", redacted} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected %q to be contained in %q", want, got)
+		}
+	}
+	for _, secret := range []string{"secret-api-key", "secret-token"} {
+		if strings.Contains(got, secret) {
+			t.Errorf("expected %q to be redacted from %q", secret, got)
+		}
+	}
+}
+
+func TestWithAPIKeySourceHint(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		err      error
+		source   string
+		expected string
+	}{
+		{
+			name:     "from config",
+			err:      status.Error(codes.Unauthenticated, "invalid api key"),
+			source:   "config",
+			expected: "api_key attribute of the provider configuration",
+		},
+		{
+			name:     "from env",
+			err:      status.Error(codes.Unauthenticated, "invalid api key"),
+			source:   "env",
+			expected: "PIPECD_API_KEY environment variable",
+		},
+		{
+			name:   "other code",
+			err:    status.Error(codes.PermissionDenied, "permission denied"),
+			source: "config",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := withAPIKeySourceHint(tc.err, tc.source)
+			if status.Code(err) != status.Code(tc.err) {
+				t.Errorf("expected code %s, got %s", status.Code(tc.err), status.Code(err))
+			}
+			if tc.expected == "" {
+				if err.Error() != tc.err.Error() {
+					t.Errorf("expected the error to be unchanged, got %q", err.Error())
+				}
+				return
+			}
+			if !strings.Contains(err.Error(), tc.expected) {
+				t.Errorf("expected %q to be contained in %q", tc.expected, err.Error())
+			}
+		})
+	}
+}
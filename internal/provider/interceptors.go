@@ -0,0 +1,78 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// traceIDEnvVar is the environment variable to take the trace ID from instead of generating one.
+	traceIDEnvVar = "TF_PIPECD_TRACE_ID"
+	// traceIDMetadataKey is the gRPC metadata key the trace ID is sent with.
+	traceIDMetadataKey = "x-pipecd-trace-id"
+)
+
+// traceIDUnaryClientInterceptor attaches the given trace ID to the metadata of every outgoing RPC
+// so that requests made in a Terraform run can be correlated with the PipeCD server logs.
+func traceIDUnaryClientInterceptor(traceID string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		tflog.Debug(ctx, "Calling PipeCD API", map[string]interface{}{"method": method, "pipecd_trace_id": traceID})
+		ctx = metadata.AppendToOutgoingContext(ctx, traceIDMetadataKey, traceID)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// loggingUnaryClientInterceptor logs the method, the resulting status code and the duration of every RPC
+// as structured fields. Run Terraform with TF_LOG=JSON to have them emitted as JSON.
+func loggingUnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		tflog.Debug(ctx, "Called PipeCD API", map[string]interface{}{
+			"method":      method,
+			"code":        status.Code(err).String(),
+			"duration_ms": time.Since(start).Milliseconds(),
+		})
+		return err
+	}
+}
+
+// apiKeySourceUnaryClientInterceptor adds a hint on where the API key came from to Unauthenticated errors.
+func apiKeySourceUnaryClientInterceptor(source string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return withAPIKeySourceHint(invoker(ctx, method, req, reply, cc, opts...), source)
+	}
+}
+
+// extraHeadersUnaryClientInterceptor attaches the given headers to the metadata of every outgoing RPC.
+func extraHeadersUnaryClientInterceptor(headers map[string]string) grpc.UnaryClientInterceptor {
+	kv := make([]string, 0, 2*len(headers))
+	for name, value := range headers {
+		kv = append(kv, name, value)
+	}
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if len(kv) > 0 {
+			ctx = metadata.AppendToOutgoingContext(ctx, kv...)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
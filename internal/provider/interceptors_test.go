@@ -0,0 +1,129 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestTraceIDUnaryClientInterceptor(t *testing.T) {
+	t.Parallel()
+
+	const traceID = "test-trace-id"
+
+	var logs bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &logs)
+
+	var outgoing metadata.MD
+	invoker := func(ctx context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		outgoing, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+
+	interceptor := traceIDUnaryClientInterceptor(traceID)
+	if err := interceptor(ctx, "/grpc.service.apiservice.APIService/GetApplication", nil, nil, nil, invoker); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+
+	if got := outgoing.Get(traceIDMetadataKey); len(got) != 1 || got[0] != traceID {
+		t.Errorf("expected trace ID %q in the outgoing metadata, got %v", traceID, got)
+	}
+	if !strings.Contains(logs.String(), traceID) {
+		t.Errorf("expected trace ID %q in the logs, got %q", traceID, logs.String())
+	}
+}
+
+func TestLoggingUnaryClientInterceptor(t *testing.T) {
+	t.Parallel()
+
+	const method = "/grpc.service.apiservice.APIService/GetApplication"
+
+	var logs bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &logs)
+
+	invoker := func(_ context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		time.Sleep(5 * time.Millisecond)
+		return status.Error(codes.NotFound, "not found")
+	}
+
+	interceptor := loggingUnaryClientInterceptor()
+	if err := interceptor(ctx, method, nil, nil, nil, invoker); status.Code(err) != codes.NotFound {
+		t.Errorf("expected the error of the invoker to be returned, got %v", err)
+		return
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&logs)
+	if err != nil {
+		t.Errorf("failed to decode logs: %v", err)
+		return
+	}
+	if len(entries) != 1 {
+		t.Errorf("expected 1 log entry, got %d", len(entries))
+		return
+	}
+
+	entry := entries[0]
+	if entry["method"] != method {
+		t.Errorf("expected method %q, got %v", method, entry["method"])
+	}
+	if entry["code"] != "NotFound" {
+		t.Errorf("expected code NotFound, got %v", entry["code"])
+	}
+	if d, ok := entry["duration_ms"].(float64); !ok || d < 5 {
+		t.Errorf("expected duration_ms to be a number of at least 5, got %v", entry["duration_ms"])
+	}
+}
+
+func TestExtraHeadersUnaryClientInterceptor(t *testing.T) {
+	t.Parallel()
+
+	var outgoing metadata.MD
+	invoker := func(ctx context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		outgoing, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), traceIDMetadataKey, "test-trace-id")
+	interceptor := extraHeadersUnaryClientInterceptor(map[string]string{
+		"X-Tenant-ID":     "tenant-1",
+		"x-gateway-token": "secret",
+	})
+	if err := interceptor(ctx, "/grpc.service.apiservice.APIService/GetApplication", nil, nil, nil, invoker); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+
+	expected := map[string]string{
+		"x-tenant-id":      "tenant-1",
+		"x-gateway-token":  "secret",
+		traceIDMetadataKey: "test-trace-id",
+	}
+	for name, value := range expected {
+		if got := outgoing.Get(name); len(got) != 1 || got[0] != value {
+			t.Errorf("expected %s: %q in the outgoing metadata, got %v", name, value, got)
+		}
+	}
+}
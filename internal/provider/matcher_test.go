@@ -0,0 +1,71 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+)
+
+// protoEq returns a gomock matcher that compares proto messages semantically,
+// ignoring the internal state of the generated structs.
+func protoEq(want proto.Message) gomock.Matcher {
+	return protoMatcher{want: want}
+}
+
+type protoMatcher struct {
+	want proto.Message
+}
+
+func (m protoMatcher) Matches(x interface{}) bool {
+	got, ok := x.(proto.Message)
+	if !ok {
+		return false
+	}
+	return cmp.Equal(m.want, got, protocmp.Transform())
+}
+
+func (m protoMatcher) String() string {
+	return fmt.Sprintf("is equal to %v", m.want)
+}
+
+func TestProtoEq(t *testing.T) {
+	t.Parallel()
+
+	want := &apiservice.GetPipedRequest{PipedId: "test_piped_id"}
+	got := &apiservice.GetPipedRequest{PipedId: "test_piped_id"}
+	// Computing the size populates the internal size cache of the message.
+	proto.Size(got)
+
+	if gomock.Eq(want).Matches(got) {
+		t.Errorf("expected gomock.Eq to be affected by the internal state of the message")
+	}
+	if !protoEq(want).Matches(got) {
+		t.Errorf("expected protoEq to ignore the internal state of the message")
+	}
+	if protoEq(want).Matches(&apiservice.GetPipedRequest{PipedId: "another_piped_id"}) {
+		t.Errorf("expected protoEq not to match a different message")
+	}
+	if protoEq(want).Matches("test_piped_id") {
+		t.Errorf("expected protoEq not to match a non proto value")
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"time"
+)
+
+// poll calls fn every interval until it reports done or returns an error.
+// It returns the context error when ctx is done before that.
+func poll(ctx context.Context, interval time.Duration, fn func(ctx context.Context) (bool, error)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		done, err := fn(ctx)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+const (
+	// importRetryAttempts and importRetryInterval bound the retries of reads on import,
+	// which may run right after the resource was created and not see it yet.
+	importRetryAttempts = 3
+	importRetryInterval = time.Second
+)
+
+// retryOnNotFound calls fn until it returns an error other than NotFound, at most attempts times waiting interval in between.
+// It returns the last error of fn.
+func retryOnNotFound(ctx context.Context, attempts int, interval time.Duration, fn func(ctx context.Context) error) error {
+	n := 0
+	return poll(ctx, interval, func(ctx context.Context) (bool, error) {
+		n++
+		err := fn(ctx)
+		if isNotFound(err) && n < attempts {
+			return false, nil
+		}
+		return true, err
+	})
+}
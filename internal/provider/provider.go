@@ -19,16 +19,32 @@ package provider
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
 	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/resolver"
 
 	api "github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
 	"github.com/pipe-cd/pipecd/pkg/rpc/rpcauth"
@@ -37,16 +53,72 @@ import (
 
 var _ provider.Provider = &PipeCDProvider{}
 
+const defaultDialTimeout = "30s"
+
+// keepaliveTime is how long the connection to PipeCD may be idle during an RPC before it is pinged.
+// gRPC servers reject pings sent more often than every 5 minutes by default.
+const keepaliveTime = 5 * time.Minute
+
+// processTraceID is the trace ID of the requests made by this provider process when none is set with TF_PIPECD_TRACE_ID.
+// It is shared by all the provider instances of a Terraform run.
+var processTraceID = sync.OnceValue(uuid.NewString)
+
+// Environment variables used when the corresponding provider attribute is not configured.
+const (
+	hostEnvVar           = "PIPECD_HOST"
+	apiKeyEnvVar         = "PIPECD_API_KEY"
+	strictEnvVar         = "PIPECD_STRICT"
+	debugEnvVar          = "PIPECD_DEBUG"
+	defaultPipedIDEnvVar = "PIPECD_DEFAULT_PIPED_ID"
+	insecureEnvVar       = "PIPECD_INSECURE"
+	caCertFileEnvVar     = "PIPECD_CA_CERT_FILE"
+	skipVerifyEnvVar     = "PIPECD_INSECURE_SKIP_VERIFY"
+	dialTimeoutEnvVar    = "PIPECD_DIAL_TIMEOUT"
+	serviceConfigEnvVar  = "PIPECD_GRPC_SERVICE_CONFIG"
+	extraHeadersEnvVar   = "PIPECD_EXTRA_HEADERS"
+)
+
 type PipeCDProvider struct {
 	version string
 	client  APIClient
 }
 
 type pipeCDProviderModel struct {
-	Host   types.String `tfsdk:"host"`
-	APIKey types.String `tfsdk:"api_key"`
+	Host               types.String `tfsdk:"host"`
+	APIKey             types.String `tfsdk:"api_key"`
+	Strict             types.Bool   `tfsdk:"strict"`
+	Debug              types.Bool   `tfsdk:"debug"`
+	DefaultPipedID     types.String `tfsdk:"default_piped_id"`
+	GRPCServiceConfig  types.String `tfsdk:"grpc_service_config"`
+	Insecure           types.Bool   `tfsdk:"insecure"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	DialTimeout        types.String `tfsdk:"dial_timeout"`
+	ExtraHeaders       types.Map    `tfsdk:"extra_headers"`
 }
 
+// providerData is passed to resources and data sources through their Configure methods.
+type providerData struct {
+	c                 APIClient
+	host              string
+	apiKeySource      string
+	traceID           string
+	strict            bool
+	debug             bool
+	defaultPipedID    string
+	tlsMode           string
+	caCertFile        string
+	dialTimeout       time.Duration
+	grpcServiceConfig string
+}
+
+// TLS modes of the connection to PipeCD, as exposed by the pipecd_provider_config data source.
+const (
+	tlsModePlaintext  = "plaintext"
+	tlsModeVerify     = "tls"
+	tlsModeSkipVerify = "tls_skip_verify"
+)
+
 func (p *PipeCDProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "pipecd"
 }
@@ -56,18 +128,81 @@ func (p *PipeCDProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 		Description: "Interact with PipeCD.",
 		Attributes: map[string]schema.Attribute{
 			"host": schema.StringAttribute{
+				Description: "The address of the PipeCD API, as host:port or as unix:///path/to.sock to connect over a unix domain socket. " +
+					"Can also be set with the PIPECD_HOST environment variable.",
 				Optional: true,
 			},
 			"api_key": schema.StringAttribute{
 				Optional:  true,
 				Sensitive: true,
 			},
+			"strict": schema.BoolAttribute{
+				Description: "Whether plan-time checks against the control plane should fail the plan instead of emitting warnings. " +
+					"Can also be set with the PIPECD_STRICT environment variable.",
+				Optional: true,
+			},
+			"debug": schema.BoolAttribute{
+				Description: "Whether to append the details attached to gRPC errors returned by PipeCD to the error messages. " +
+					"Can also be set with the PIPECD_DEBUG environment variable.",
+				Optional: true,
+			},
+			"default_piped_id": schema.StringAttribute{
+				Description: "The ID of piped used by applications that do not set piped_id. " +
+					"Can also be set with the PIPECD_DEFAULT_PIPED_ID environment variable.",
+				Optional: true,
+			},
+			"insecure": schema.BoolAttribute{
+				Description: "Whether to connect to PipeCD over plaintext instead of TLS, e.g. to a control plane running locally. " +
+					"The API key is sent unencrypted, so do not use it over untrusted networks. " +
+					"Can also be set with the PIPECD_INSECURE environment variable.",
+				Optional: true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "The path to a PEM file with the CA certificates to verify the PipeCD server with instead of the system ones, " +
+					"e.g. when the control plane sits behind a private CA. " +
+					"Can also be set with the PIPECD_CA_CERT_FILE environment variable.",
+				Optional: true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Whether to skip verifying the certificate of the PipeCD server. " +
+					"It makes the connection open to man-in-the-middle attacks, so prefer ca_cert_file where possible. " +
+					"Can also be set with the PIPECD_INSECURE_SKIP_VERIFY environment variable.",
+				Optional: true,
+			},
+			"dial_timeout": schema.StringAttribute{
+				Description: "How long to wait for the connection to PipeCD to be established. (default \"" + defaultDialTimeout + "\") " +
+					"Can also be set with the PIPECD_DIAL_TIMEOUT environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"extra_headers": schema.MapAttribute{
+				Description: "Extra gRPC metadata sent with every request to PipeCD, " +
+					"e.g. the headers required by a gateway in front of the control plane. " +
+					"The values of the headers whose name looks sensitive, e.g. contains \"token\" or \"key\", are masked in the logs. " +
+					"Can also be set with the PIPECD_EXTRA_HEADERS environment variable as comma-separated name=value pairs.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"grpc_service_config": schema.StringAttribute{
+				Description: "A raw gRPC service config in JSON used as the default service config of the connection to PipeCD, " +
+					"e.g. to set method configs with retry policies and timeouts. " +
+					"It is an escape hatch for advanced use and is applied as is, on top of the other provider attributes. " +
+					"See https://github.com/grpc/grpc/blob/master/doc/service_config.md for the format. " +
+					"Can also be set with the PIPECD_GRPC_SERVICE_CONFIG environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					jsonObjectValidator{},
+				},
+			},
 		},
 	}
 }
 
 func (p *PipeCDProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	tflog.Info(ctx, "Configuring PipeCD client")
+	logPipeCDVersion(ctx)
 
 	var config pipeCDProviderModel
 	diags := req.Config.Get(ctx, &config)
@@ -76,8 +211,119 @@ func (p *PipeCDProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
-	if config.Host.IsUnknown() {
+	cfg, diags := resolveConfig(config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tlsConfig, err := newTLSConfig(cfg.caCertFile, cfg.insecureSkipVerify)
+	if err != nil {
 		resp.Diagnostics.AddAttributeError(
+			path.Root("ca_cert_file"),
+			"Invalid CA Certificate",
+			"The provider cannot load the CA certificates set with ca_cert_file or the PIPECD_CA_CERT_FILE environment variable: "+err.Error(),
+		)
+		return
+	}
+
+	traceID := os.Getenv(traceIDEnvVar)
+	if traceID == "" {
+		traceID = processTraceID()
+	}
+
+	ctx = tflog.SetField(ctx, "pipecd_host", cfg.host)
+	ctx = tflog.SetField(ctx, "pipecd_api_key", cfg.apiKey)
+	ctx = tflog.SetField(ctx, "pipecd_trace_id", traceID)
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "pipecd_api_key")
+	for name, value := range cfg.extraHeaders {
+		field := "pipecd_header_" + name
+		ctx = tflog.SetField(ctx, field, value)
+		if isSensitiveKey(name) {
+			ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, field)
+		}
+	}
+
+	tflog.Debug(ctx, "Creating PipeCD client")
+
+	if p.client == nil {
+		dialCtx, cancel := context.WithTimeout(ctx, cfg.dialTimeout)
+		defer cancel()
+		client, err := sharedAPIClient(dialCtx, cfg, tlsConfig, traceID)
+		if errors.Is(err, context.DeadlineExceeded) {
+			resp.Diagnostics.AddError(
+				"Unable to Connect to PipeCD",
+				fmt.Sprintf("The provider could not connect to PipeCD at %s within %s. ", cfg.host, cfg.dialTimeout)+
+					"Check that the host is correct and reachable from where Terraform runs, or raise dial_timeout if the connection is slow.",
+			)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Create PipeCD API Client",
+				"An unexpected error occurred when creating the PipeCD API client. "+
+					"If the error is not clear, please contact the provider developers.\n\n"+
+					"PipeCD Client Error: "+err.Error(),
+			)
+			return
+		}
+		p.client = client
+	}
+
+	data := &providerData{
+		c:                 p.client,
+		host:              cfg.host,
+		apiKeySource:      cfg.apiKeySource,
+		traceID:           traceID,
+		strict:            cfg.strict,
+		debug:             cfg.debug,
+		defaultPipedID:    cfg.defaultPipedID,
+		tlsMode:           cfg.tlsMode(),
+		caCertFile:        cfg.caCertFile,
+		dialTimeout:       cfg.dialTimeout,
+		grpcServiceConfig: cfg.grpcServiceConfig,
+	}
+	resp.DataSourceData = data
+	resp.ResourceData = data
+
+	tflog.Info(ctx, "Configured PipeCD client", map[string]any{"success": true})
+	tflog.Info(ctx, "Requests to PipeCD are sent with trace ID "+traceID+" in the "+traceIDMetadataKey+" metadata")
+}
+
+// resolvedConfig is the provider configuration after falling back to the environment variables.
+type resolvedConfig struct {
+	host               string
+	apiKey             string
+	apiKeySource       string
+	strict             bool
+	debug              bool
+	defaultPipedID     string
+	grpcServiceConfig  string
+	insecure           bool
+	caCertFile         string
+	insecureSkipVerify bool
+	dialTimeout        time.Duration
+	extraHeaders       map[string]string
+}
+
+// tlsMode returns how the connection to PipeCD is secured.
+func (c resolvedConfig) tlsMode() string {
+	switch {
+	case c.insecure:
+		return tlsModePlaintext
+	case c.insecureSkipVerify:
+		return tlsModeSkipVerify
+	default:
+		return tlsModeVerify
+	}
+}
+
+// resolveConfig resolves the provider configuration, falling back to the environment variables for the unset attributes.
+func resolveConfig(config pipeCDProviderModel) (resolvedConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if config.Host.IsUnknown() {
+		diags.AddAttributeError(
 			path.Root("host"),
 			"Unknown PipeCD API Host",
 			"The provider cannot create the PipeCD API client as there is an unknown configuration value for the PipeCD API host. "+
@@ -86,7 +332,7 @@ func (p *PipeCDProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	}
 
 	if config.APIKey.IsUnknown() {
-		resp.Diagnostics.AddAttributeError(
+		diags.AddAttributeError(
 			path.Root("api_key"),
 			"Unknown PipeCD API Key",
 			"The provider cannot create the PipeCD API client as there is an unknown configuration value for the PipeCD API Key. "+
@@ -94,23 +340,94 @@ func (p *PipeCDProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		)
 	}
 
-	if resp.Diagnostics.HasError() {
-		return
+	if config.ExtraHeaders.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("extra_headers"),
+			"Unknown PipeCD Extra Headers",
+			"The provider cannot create the PipeCD API client as there is an unknown configuration value for the extra headers. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if diags.HasError() {
+		return resolvedConfig{}, diags
 	}
 
-	host := os.Getenv("PIPECD_HOST")
-	apiKey := os.Getenv("PIPECD_API_KEY")
+	host := stringValueOrEnv(config.Host, hostEnvVar)
+	apiKey := stringValueOrEnv(config.APIKey, apiKeyEnvVar)
+	apiKeySource := "config"
+	if config.APIKey.IsNull() {
+		apiKeySource = "env"
+	}
+	defaultPipedID := stringValueOrEnv(config.DefaultPipedID, defaultPipedIDEnvVar)
+
+	strict, d := boolValueOrEnv(path.Root("strict"), config.Strict, strictEnvVar)
+	diags.Append(d...)
+	debug, d := boolValueOrEnv(path.Root("debug"), config.Debug, debugEnvVar)
+	diags.Append(d...)
+	insecure, d := boolValueOrEnv(path.Root("insecure"), config.Insecure, insecureEnvVar)
+	diags.Append(d...)
+	caCertFile := stringValueOrEnv(config.CACertFile, caCertFileEnvVar)
+	insecureSkipVerify, d := boolValueOrEnv(path.Root("insecure_skip_verify"), config.InsecureSkipVerify, skipVerifyEnvVar)
+	diags.Append(d...)
+
+	dialTimeoutValue := stringValueOrEnv(config.DialTimeout, dialTimeoutEnvVar)
+	if dialTimeoutValue == "" {
+		dialTimeoutValue = defaultDialTimeout
+	}
+	// The configured value is validated by the schema, so only the environment variable can be invalid here.
+	dialTimeout, err := time.ParseDuration(dialTimeoutValue)
+	if err != nil || dialTimeout <= 0 {
+		diags.AddAttributeError(
+			path.Root("dial_timeout"),
+			"Invalid Environment Variable",
+			"The provider cannot parse the value of the "+dialTimeoutEnvVar+` environment variable as a positive duration such as "30s", got "`+dialTimeoutValue+`".`,
+		)
+	}
+
+	// The configured value is validated by the schema, so only the environment variable can be invalid here.
+	grpcServiceConfig := stringValueOrEnv(config.GRPCServiceConfig, serviceConfigEnvVar)
+	if grpcServiceConfig != "" {
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(grpcServiceConfig), &obj); err != nil {
+			diags.AddAttributeError(
+				path.Root("grpc_service_config"),
+				"Invalid Environment Variable",
+				"The provider cannot parse the value of the "+serviceConfigEnvVar+" environment variable as a JSON object: "+err.Error(),
+			)
+		}
+	}
 
-	if !config.Host.IsNull() {
-		host = config.Host.ValueString()
+	var extraHeaders map[string]string
+	for name, value := range config.ExtraHeaders.Elements() {
+		if extraHeaders == nil {
+			extraHeaders = make(map[string]string, len(config.ExtraHeaders.Elements()))
+		}
+		extraHeaders[name] = value.(types.String).ValueString()
+	}
+	if config.ExtraHeaders.IsNull() {
+		headers, err := parseExtraHeaders(os.Getenv(extraHeadersEnvVar))
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("extra_headers"),
+				"Invalid Environment Variable",
+				"The provider cannot parse the value of the "+extraHeadersEnvVar+" environment variable as comma-separated name=value pairs: "+err.Error(),
+			)
+		}
+		extraHeaders = headers
 	}
 
-	if !config.APIKey.IsNull() {
-		apiKey = config.APIKey.ValueString()
+	if insecure && (caCertFile != "" || insecureSkipVerify) {
+		diags.AddAttributeError(
+			path.Root("insecure"),
+			"Conflicting TLS Configuration",
+			"The provider cannot use ca_cert_file or insecure_skip_verify when insecure is set, as the connection does not use TLS. "+
+				"Unset either of them in the configuration or the corresponding environment variables.",
+		)
 	}
 
 	if host == "" {
-		resp.Diagnostics.AddAttributeError(
+		diags.AddAttributeError(
 			path.Root("host"),
 			"Missing PipeCD API Host",
 			"The provider cannot create the PipeCD API client as there is a missing or empty value for the PipeCD API host. "+
@@ -119,8 +436,19 @@ func (p *PipeCDProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		)
 	}
 
+	if host != "" {
+		if _, _, err := hostDialOptions(host); err != nil {
+			diags.AddAttributeError(
+				path.Root("host"),
+				"Invalid PipeCD API Host",
+				"The provider cannot create the PipeCD API client as the PipeCD API host is invalid: "+err.Error()+". "+
+					"Set the host as host:port, or as unix:///path/to.sock to connect over a unix domain socket.",
+			)
+		}
+	}
+
 	if apiKey == "" {
-		resp.Diagnostics.AddAttributeError(
+		diags.AddAttributeError(
 			path.Root("api_key"),
 			"Missing PipeCD API Key",
 			"The provider cannot create the PipeCD API client as there is a missing or empty value for the PipeCD API Key. "+
@@ -129,53 +457,238 @@ func (p *PipeCDProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		)
 	}
 
-	if resp.Diagnostics.HasError() {
-		return
+	return resolvedConfig{
+		host:               host,
+		apiKey:             apiKey,
+		apiKeySource:       apiKeySource,
+		strict:             strict,
+		debug:              debug,
+		defaultPipedID:     defaultPipedID,
+		grpcServiceConfig:  grpcServiceConfig,
+		insecure:           insecure,
+		caCertFile:         caCertFile,
+		insecureSkipVerify: insecureSkipVerify,
+		dialTimeout:        dialTimeout,
+		extraHeaders:       extraHeaders,
+	}, diags
+}
+
+// stringValueOrEnv returns the configured value, or the value of the given environment variable if v is null.
+func stringValueOrEnv(v types.String, env string) string {
+	if !v.IsNull() {
+		return v.ValueString()
 	}
+	return os.Getenv(env)
+}
 
-	ctx = tflog.SetField(ctx, "pipecd_host", host)
-	ctx = tflog.SetField(ctx, "pipecd_api_key", apiKey)
-	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "pipecd_api_key")
+// parseExtraHeaders parses comma-separated name=value pairs, e.g. "x-tenant-id=tenant-1,x-env=prod".
+// It returns nil if s is empty.
+func parseExtraHeaders(s string) (map[string]string, error) {
+	if s == "" {
+		return nil, nil
+	}
+	pairs := strings.Split(s, ",")
+	headers := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		name, value, ok := strings.Cut(pair, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("%q is not a name=value pair", pair)
+		}
+		headers[name] = strings.TrimSpace(value)
+	}
+	return headers, nil
+}
 
-	tflog.Debug(ctx, "Creating PipeCD client")
+// boolValueOrEnv returns the configured value, or the value of the given environment variable if v is null.
+// An unset environment variable is treated as false.
+func boolValueOrEnv(p path.Path, v types.Bool, env string) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if !v.IsNull() {
+		return v.ValueBool(), diags
+	}
+	s := os.Getenv(env)
+	if s == "" {
+		return false, diags
+	}
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		diags.AddAttributeError(
+			p,
+			"Invalid Environment Variable",
+			"The provider cannot parse the value of the "+env+" environment variable as a boolean: "+err.Error(),
+		)
+		return false, diags
+	}
+	return b, diags
+}
 
-	if p.client == nil {
-		creds := rpcclient.NewPerRPCCredentials(apiKey, rpcauth.APIKeyCredentials, true)
-		tlsConfig := &tls.Config{}
-		options := []rpcclient.DialOption{
-			rpcclient.WithBlock(),
-			rpcclient.WithPerRPCCredentials(creds),
-			rpcclient.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+// newTLSConfig returns the TLS config to connect to PipeCD with.
+// The CA certificates in caCertFile are trusted instead of the system ones if it is set.
+func newTLSConfig(caCertFile string, insecureSkipVerify bool) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: insecureSkipVerify, //nolint:gosec // Explicitly requested by the user.
+	}
+	if caCertFile == "" {
+		return tlsConfig, nil
+	}
+
+	pem, err := os.ReadFile(caCertFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no PEM encoded certificate found in %s", caCertFile)
+	}
+	tlsConfig.RootCAs = pool
+	return tlsConfig, nil
+}
+
+// newAPIClient connects to the PipeCD API at cfg.host, over TLS with tlsConfig unless cfg.insecure is set.
+func newAPIClient(ctx context.Context, cfg resolvedConfig, tlsConfig *tls.Config, traceID string) (APIClient, error) {
+	// The API key may only be sent in plaintext when insecure is explicitly set.
+	creds := rpcclient.NewPerRPCCredentials(cfg.apiKey, rpcauth.APIKeyCredentials, !cfg.insecure)
+	transport := rpcclient.WithTransportCredentials(credentials.NewTLS(tlsConfig))
+	if cfg.insecure {
+		transport = rpcclient.WithInsecure()
+	}
+	options, err := rpcclient.DialOptions(
+		rpcclient.WithBlock(),
+		rpcclient.WithPerRPCCredentials(creds),
+		transport,
+	)
+	if err != nil {
+		return nil, err
+	}
+	options = append(options, grpc.WithChainUnaryInterceptor(
+		loggingUnaryClientInterceptor(),
+		traceIDUnaryClientInterceptor(traceID),
+		apiKeySourceUnaryClientInterceptor(cfg.apiKeySource),
+		extraHeadersUnaryClientInterceptor(cfg.extraHeaders),
+	))
+	options = append(options, grpc.WithKeepaliveParams(keepalive.ClientParameters{Time: keepaliveTime}))
+	options = append(options, serviceConfigDialOptions(cfg.grpcServiceConfig)...)
+	target, hostOptions, err := hostDialOptions(cfg.host)
+	if err != nil {
+		return nil, err
+	}
+	options = append(options, hostOptions...)
+
+	// DialContext is still required to honour WithBlock.
+	conn, err := grpc.DialContext(ctx, target, options...) //nolint:staticcheck
+	if err != nil {
+		return nil, err
+	}
+	return api.NewAPIServiceClient(conn), nil
+}
+
+// apiClients are the API clients created by this provider process, keyed by the settings they were created with.
+var apiClients = struct {
+	sync.Mutex
+	m map[apiClientKey]APIClient
+}{m: make(map[apiClientKey]APIClient)}
+
+// apiClientKey is the part of the provider configuration the API client depends on.
+type apiClientKey struct {
+	host               string
+	apiKey             string
+	apiKeySource       string
+	grpcServiceConfig  string
+	insecure           bool
+	caCertFile         string
+	insecureSkipVerify bool
+	extraHeaders       string
+	traceID            string
+}
+
+// sharedAPIClient returns the API client created for the same configuration by another provider instance of this process,
+// or creates one with newAPIClient, so that they share a single connection to PipeCD.
+func sharedAPIClient(ctx context.Context, cfg resolvedConfig, tlsConfig *tls.Config, traceID string) (APIClient, error) {
+	key := apiClientKey{
+		host:               cfg.host,
+		apiKey:             cfg.apiKey,
+		apiKeySource:       cfg.apiKeySource,
+		grpcServiceConfig:  cfg.grpcServiceConfig,
+		insecure:           cfg.insecure,
+		caCertFile:         cfg.caCertFile,
+		insecureSkipVerify: cfg.insecureSkipVerify,
+		// Maps are printed sorted by key.
+		extraHeaders: fmt.Sprint(cfg.extraHeaders),
+		traceID:      traceID,
+	}
+
+	apiClients.Lock()
+	defer apiClients.Unlock()
+
+	if client, ok := apiClients.m[key]; ok {
+		return client, nil
+	}
+	client, err := newAPIClient(ctx, cfg, tlsConfig, traceID)
+	if err != nil {
+		return nil, err
+	}
+	apiClients.m[key] = client
+	return client, nil
+}
+
+// hostDialOptions returns the target to dial the given host with and the dial options it requires.
+// The host is either host:port, unix:///path/to.sock for a unix domain socket, or a target with a scheme gRPC resolves.
+func hostDialOptions(host string) (string, []grpc.DialOption, error) {
+	if !strings.Contains(host, "://") {
+		return host, nil, nil
+	}
+
+	u, err := url.Parse(host)
+	if err != nil {
+		return "", nil, err
+	}
+	switch {
+	case u.Scheme == "unix":
+		if u.Path == "" {
+			return "", nil, fmt.Errorf("no socket path in %s", host)
 		}
-		client, err := api.NewClient(ctx, host, options...)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Unable to Create PipeCD API Client",
-				"An unexpected error occurred when creating the PipeCD API client. "+
-					"If the error is not clear, please contact the provider developers.\n\n"+
-					"PipeCD Client Error: "+err.Error(),
-			)
-			return
+		dialer := func(ctx context.Context, addr string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "unix", addr)
 		}
-		p.client = client
+		return "passthrough:///" + u.Path, []grpc.DialOption{grpc.WithContextDialer(dialer)}, nil
+	case resolver.Get(u.Scheme) != nil:
+		// Other schemes known to gRPC, e.g. dns:///, are resolved by gRPC itself.
+		return host, nil, nil
+	default:
+		return "", nil, fmt.Errorf("unsupported scheme %q", u.Scheme)
 	}
+}
 
-	resp.DataSourceData = p.client
-	resp.ResourceData = p.client
-
-	tflog.Info(ctx, "Configured PipeCD client", map[string]any{"success": true})
+// serviceConfigDialOptions returns the dial options applying the given gRPC service config, if any.
+func serviceConfigDialOptions(serviceConfig string) []grpc.DialOption {
+	if serviceConfig == "" {
+		return nil
+	}
+	return []grpc.DialOption{grpc.WithDefaultServiceConfig(serviceConfig)}
 }
 
 func (p *PipeCDProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewApplicationDataSource,
+		NewApplicationManifestDataSource,
+		NewApplicationsDataSource,
+		NewApplicationReadinessDataSource,
 		NewPipedDataSource,
+		NewDeploymentDataSource,
+		NewDeploymentsDataSource,
+		NewDeploymentStagesDataSource,
+		NewPlanPreviewDataSource,
+		NewProviderConfigDataSource,
 	}
 }
 
 func (p *PipeCDProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewApplicationResource,
+		NewApplicationSyncResource,
+		NewEventResource,
 		NewPipedResource,
 	}
 }
@@ -15,8 +15,46 @@
 package provider
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/model"
+	"github.com/pipe-cd/terraform-provider-pipecd/internal/provider/mock"
 )
 
 const (
@@ -36,3 +74,970 @@ func protoV6ProviderFactories(client APIClient) map[string]func() (tfprotov6.Pro
 		}),
 	}
 }
+
+func TestAccProviderConfigFromEnv(t *testing.T) {
+	t.Setenv(hostEnvVar, "localhost:8018")
+	t.Setenv(apiKeyEnvVar, "test")
+	t.Setenv(strictEnvVar, "false")
+	t.Setenv(debugEnvVar, "true")
+	t.Setenv(defaultPipedIDEnvVar, "env_piped_id")
+	t.Setenv(dialTimeoutEnvVar, "10s")
+	t.Setenv(serviceConfigEnvVar, `{"methodConfig": []}`)
+	t.Setenv(extraHeadersEnvVar, "x-tenant-id=tenant-1")
+
+	const appID = "test_application_id"
+
+	app := &model.Application{
+		Id:      appID,
+		Name:    "test_application",
+		PipedId: "env_piped_id",
+		GitPath: &model.ApplicationGitPath{
+			Repo: &model.ApplicationGitRepository{
+				Id: "repo_id",
+			},
+			Path:           "path/to/config",
+			ConfigFilename: "app.pipecd.yaml",
+		},
+		Kind:             model.ApplicationKind_KUBERNETES,
+		PlatformProvider: "test_provider",
+	}
+
+	addReq := &apiservice.AddApplicationRequest{
+		Name:             app.Name,
+		PipedId:          app.PipedId,
+		GitPath:          app.GitPath,
+		Kind:             app.Kind,
+		PlatformProvider: app.PlatformProvider,
+	}
+	addResp := &apiservice.AddApplicationResponse{ApplicationId: appID}
+
+	getReq := &apiservice.GetApplicationRequest{ApplicationId: appID}
+	getResp := &apiservice.GetApplicationResponse{Application: app}
+
+	deleteReq := &apiservice.DeleteApplicationRequest{ApplicationId: appID}
+	deleteResp := &apiservice.DeleteApplicationResponse{ApplicationId: appID}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	expectEnabledPiped(client, app.PipedId)
+	client.EXPECT().AddApplication(gomock.Any(), protoEq(addReq)).Return(addResp, nil).AnyTimes()
+	client.EXPECT().GetApplication(gomock.Any(), protoEq(getReq)).Return(getResp, nil).AnyTimes()
+	client.EXPECT().DeleteApplication(gomock.Any(), protoEq(deleteReq)).Return(deleteResp, nil).AnyTimes()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "pipecd" {}
+` + testAccResourceApplicationWithoutPipedID(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pipecd_application.test", "piped_id", "env_piped_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestBoolValueOrEnv(t *testing.T) {
+	testcases := []struct {
+		name     string
+		value    types.Bool
+		env      string
+		expected bool
+		wantErr  bool
+	}{
+		{
+			name:     "configured value takes precedence",
+			value:    types.BoolValue(false),
+			env:      "true",
+			expected: false,
+		},
+		{
+			name:     "from env",
+			value:    types.BoolNull(),
+			env:      "true",
+			expected: true,
+		},
+		{
+			name:     "unset env",
+			value:    types.BoolNull(),
+			expected: false,
+		},
+		{
+			name:    "invalid env",
+			value:   types.BoolNull(),
+			env:     "yes please",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv(strictEnvVar, tc.env)
+
+			got, diags := boolValueOrEnv(path.Root("strict"), tc.value, strictEnvVar)
+			if diags.HasError() != tc.wantErr {
+				t.Errorf("unexpected diagnostics: %v", diags)
+				return
+			}
+			if got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestResolvedConfigTLSMode(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		cfg      resolvedConfig
+		expected string
+	}{
+		{
+			name:     "system CAs",
+			expected: tlsModeVerify,
+		},
+		{
+			name:     "ca cert file",
+			cfg:      resolvedConfig{caCertFile: "/etc/pipecd/ca.pem"},
+			expected: tlsModeVerify,
+		},
+		{
+			name:     "skip verify",
+			cfg:      resolvedConfig{insecureSkipVerify: true},
+			expected: tlsModeSkipVerify,
+		},
+		{
+			name:     "insecure",
+			cfg:      resolvedConfig{insecure: true},
+			expected: tlsModePlaintext,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tc.cfg.tlsMode(); got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestParseExtraHeaders(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		value    string
+		expected map[string]string
+		wantErr  bool
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:     "single header",
+			value:    "x-tenant-id=tenant-1",
+			expected: map[string]string{"x-tenant-id": "tenant-1"},
+		},
+		{
+			name:     "several headers with spaces",
+			value:    "x-tenant-id = tenant-1, x-env=prod",
+			expected: map[string]string{"x-tenant-id": "tenant-1", "x-env": "prod"},
+		},
+		{
+			name:     "value with equal sign",
+			value:    "x-token=a=b",
+			expected: map[string]string{"x-token": "a=b"},
+		},
+		{
+			name:    "missing value",
+			value:   "x-tenant-id",
+			wantErr: true,
+		},
+		{
+			name:    "missing name",
+			value:   "x-env=prod,=tenant-1",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseExtraHeaders(tc.value)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if !cmp.Equal(tc.expected, got) {
+				t.Errorf("unexpected headers (-want +got):\n%s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
+
+func TestResolveConfig(t *testing.T) {
+	testcases := []struct {
+		name       string
+		config     pipeCDProviderModel
+		env        map[string]string
+		expected   resolvedConfig
+		wantErrors []string
+	}{
+		{
+			name: "config only",
+			config: pipeCDProviderModel{
+				Host:           types.StringValue("pipecd.example.com:443"),
+				APIKey:         types.StringValue("config-key"),
+				Strict:         types.BoolValue(true),
+				DefaultPipedID: types.StringValue("config-piped"),
+			},
+			expected: resolvedConfig{
+				host:           "pipecd.example.com:443",
+				apiKey:         "config-key",
+				apiKeySource:   "config",
+				dialTimeout:    30 * time.Second,
+				strict:         true,
+				defaultPipedID: "config-piped",
+			},
+		},
+		{
+			name: "env only",
+			env: map[string]string{
+				hostEnvVar:           "pipecd.example.com:443",
+				apiKeyEnvVar:         "env-key",
+				debugEnvVar:          "1",
+				defaultPipedIDEnvVar: "env-piped",
+				dialTimeoutEnvVar:    "10s",
+				serviceConfigEnvVar:  `{"methodConfig": []}`,
+				extraHeadersEnvVar:   "x-tenant-id=tenant-1",
+			},
+			expected: resolvedConfig{
+				host:              "pipecd.example.com:443",
+				apiKey:            "env-key",
+				apiKeySource:      "env",
+				dialTimeout:       10 * time.Second,
+				debug:             true,
+				defaultPipedID:    "env-piped",
+				grpcServiceConfig: `{"methodConfig": []}`,
+				extraHeaders:      map[string]string{"x-tenant-id": "tenant-1"},
+			},
+		},
+		{
+			name: "config takes precedence over env",
+			config: pipeCDProviderModel{
+				Host:   types.StringValue("config.example.com:443"),
+				APIKey: types.StringValue("config-key"),
+				Strict: types.BoolValue(false),
+			},
+			env: map[string]string{
+				hostEnvVar:   "env.example.com:443",
+				apiKeyEnvVar: "env-key",
+				strictEnvVar: "true",
+			},
+			expected: resolvedConfig{
+				host:         "config.example.com:443",
+				apiKey:       "config-key",
+				apiKeySource: "config",
+				dialTimeout:  30 * time.Second,
+			},
+		},
+		{
+			name: "host and api key from different sources",
+			config: pipeCDProviderModel{
+				Host: types.StringValue("config.example.com:443"),
+			},
+			env: map[string]string{
+				hostEnvVar:   "env.example.com:443",
+				apiKeyEnvVar: "env-key",
+			},
+			expected: resolvedConfig{
+				host:         "config.example.com:443",
+				apiKey:       "env-key",
+				apiKeySource: "env",
+				dialTimeout:  30 * time.Second,
+			},
+		},
+		{
+			name: "grpc service config",
+			config: pipeCDProviderModel{
+				Host:              types.StringValue("pipecd.example.com:443"),
+				APIKey:            types.StringValue("config-key"),
+				GRPCServiceConfig: types.StringValue(`{"methodConfig": []}`),
+			},
+			expected: resolvedConfig{
+				host:              "pipecd.example.com:443",
+				apiKey:            "config-key",
+				apiKeySource:      "config",
+				dialTimeout:       30 * time.Second,
+				grpcServiceConfig: `{"methodConfig": []}`,
+			},
+		},
+		{
+			name: "insecure from env",
+			env: map[string]string{
+				hostEnvVar:     "localhost:8080",
+				apiKeyEnvVar:   "env-key",
+				insecureEnvVar: "true",
+			},
+			expected: resolvedConfig{
+				host:         "localhost:8080",
+				apiKey:       "env-key",
+				apiKeySource: "env",
+				dialTimeout:  30 * time.Second,
+				insecure:     true,
+			},
+		},
+		{
+			name: "ca cert file and skip verify from env",
+			env: map[string]string{
+				hostEnvVar:       "pipecd.example.com:443",
+				apiKeyEnvVar:     "env-key",
+				caCertFileEnvVar: "/etc/pipecd/ca.pem",
+				skipVerifyEnvVar: "true",
+			},
+			expected: resolvedConfig{
+				host:               "pipecd.example.com:443",
+				apiKey:             "env-key",
+				apiKeySource:       "env",
+				dialTimeout:        30 * time.Second,
+				caCertFile:         "/etc/pipecd/ca.pem",
+				insecureSkipVerify: true,
+			},
+		},
+		{
+			name: "insecure with ca cert file",
+			config: pipeCDProviderModel{
+				Host:       types.StringValue("localhost:8080"),
+				APIKey:     types.StringValue("config-key"),
+				Insecure:   types.BoolValue(true),
+				CACertFile: types.StringValue("/etc/pipecd/ca.pem"),
+			},
+			wantErrors: []string{"Conflicting TLS Configuration"},
+		},
+		{
+			name: "insecure with skip verify from env",
+			config: pipeCDProviderModel{
+				Host:     types.StringValue("localhost:8080"),
+				APIKey:   types.StringValue("config-key"),
+				Insecure: types.BoolValue(true),
+			},
+			env: map[string]string{
+				skipVerifyEnvVar: "true",
+			},
+			wantErrors: []string{"Conflicting TLS Configuration"},
+		},
+		{
+			name: "host with scheme is passed as is",
+			config: pipeCDProviderModel{
+				Host:   types.StringValue("dns:///pipecd.example.com:443"),
+				APIKey: types.StringValue("config-key"),
+			},
+			expected: resolvedConfig{
+				host:         "dns:///pipecd.example.com:443",
+				apiKey:       "config-key",
+				apiKeySource: "config",
+				dialTimeout:  30 * time.Second,
+			},
+		},
+		{
+			name: "dial timeout",
+			config: pipeCDProviderModel{
+				Host:        types.StringValue("pipecd.example.com:443"),
+				APIKey:      types.StringValue("config-key"),
+				DialTimeout: types.StringValue("5s"),
+			},
+			expected: resolvedConfig{
+				host:         "pipecd.example.com:443",
+				apiKey:       "config-key",
+				apiKeySource: "config",
+				dialTimeout:  5 * time.Second,
+			},
+		},
+		{
+			name: "extra headers",
+			config: pipeCDProviderModel{
+				Host:   types.StringValue("pipecd.example.com:443"),
+				APIKey: types.StringValue("config-key"),
+				ExtraHeaders: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"x-tenant-id": types.StringValue("tenant-1"),
+				}),
+			},
+			expected: resolvedConfig{
+				host:         "pipecd.example.com:443",
+				apiKey:       "config-key",
+				apiKeySource: "config",
+				dialTimeout:  30 * time.Second,
+				extraHeaders: map[string]string{"x-tenant-id": "tenant-1"},
+			},
+		},
+		{
+			name: "unix socket host",
+			config: pipeCDProviderModel{
+				Host:   types.StringValue("unix:///var/run/pipecd.sock"),
+				APIKey: types.StringValue("config-key"),
+			},
+			expected: resolvedConfig{
+				host:         "unix:///var/run/pipecd.sock",
+				apiKey:       "config-key",
+				apiKeySource: "config",
+				dialTimeout:  30 * time.Second,
+			},
+		},
+		{
+			name: "unsupported host scheme",
+			config: pipeCDProviderModel{
+				Host:   types.StringValue("https://pipecd.example.com"),
+				APIKey: types.StringValue("config-key"),
+			},
+			wantErrors: []string{"Invalid PipeCD API Host"},
+		},
+		{
+			name: "empty config value does not fall back to env",
+			config: pipeCDProviderModel{
+				Host:   types.StringValue(""),
+				APIKey: types.StringValue("config-key"),
+			},
+			env: map[string]string{
+				hostEnvVar: "env.example.com:443",
+			},
+			wantErrors: []string{"Missing PipeCD API Host"},
+		},
+		{
+			name:       "nothing configured",
+			wantErrors: []string{"Missing PipeCD API Host", "Missing PipeCD API Key"},
+		},
+		{
+			name: "unknown values",
+			config: pipeCDProviderModel{
+				Host:   types.StringUnknown(),
+				APIKey: types.StringUnknown(),
+			},
+			env: map[string]string{
+				hostEnvVar:   "env.example.com:443",
+				apiKeyEnvVar: "env-key",
+			},
+			wantErrors: []string{"Unknown PipeCD API Host", "Unknown PipeCD API Key"},
+		},
+		{
+			name: "invalid boolean env",
+			env: map[string]string{
+				hostEnvVar:   "pipecd.example.com:443",
+				apiKeyEnvVar: "env-key",
+				debugEnvVar:  "verbose",
+			},
+			wantErrors: []string{"Invalid Environment Variable"},
+		},
+		{
+			name: "invalid dial timeout env",
+			env: map[string]string{
+				hostEnvVar:        "pipecd.example.com:443",
+				apiKeyEnvVar:      "env-key",
+				dialTimeoutEnvVar: "-1s",
+			},
+			wantErrors: []string{"Invalid Environment Variable"},
+		},
+		{
+			name: "invalid grpc service config env",
+			env: map[string]string{
+				hostEnvVar:          "pipecd.example.com:443",
+				apiKeyEnvVar:        "env-key",
+				serviceConfigEnvVar: `[]`,
+			},
+			wantErrors: []string{"Invalid Environment Variable"},
+		},
+		{
+			name: "invalid extra headers env",
+			env: map[string]string{
+				hostEnvVar:         "pipecd.example.com:443",
+				apiKeyEnvVar:       "env-key",
+				extraHeadersEnvVar: "x-tenant-id",
+			},
+			wantErrors: []string{"Invalid Environment Variable"},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, env := range []string{
+				hostEnvVar, apiKeyEnvVar, strictEnvVar, debugEnvVar, defaultPipedIDEnvVar,
+				insecureEnvVar, caCertFileEnvVar, skipVerifyEnvVar, dialTimeoutEnvVar, serviceConfigEnvVar, extraHeadersEnvVar,
+			} {
+				t.Setenv(env, tc.env[env])
+			}
+			// The zero values of the attributes are null.
+			got, diags := resolveConfig(tc.config)
+
+			var errs []string
+			for _, d := range diags.Errors() {
+				errs = append(errs, d.Summary())
+			}
+			if !cmp.Equal(tc.wantErrors, errs) {
+				t.Errorf("unexpected errors (-want +got):\n%s", cmp.Diff(tc.wantErrors, errs))
+				return
+			}
+			if tc.wantErrors != nil {
+				return
+			}
+			if !cmp.Equal(tc.expected, got, cmp.AllowUnexported(resolvedConfig{})) {
+				t.Errorf("unexpected config (-want +got):\n%s", cmp.Diff(tc.expected, got, cmp.AllowUnexported(resolvedConfig{})))
+			}
+		})
+	}
+}
+
+// flakyAPIServer fails the first GetPiped call with Unavailable.
+type flakyAPIServer struct {
+	apiservice.UnimplementedAPIServiceServer
+	calls atomic.Int32
+}
+
+func (s *flakyAPIServer) GetPiped(_ context.Context, req *apiservice.GetPipedRequest) (*apiservice.GetPipedResponse, error) {
+	if s.calls.Add(1) == 1 {
+		return nil, status.Error(codes.Unavailable, "temporarily unavailable")
+	}
+	return &apiservice.GetPipedResponse{Piped: &model.Piped{Id: req.PipedId}}, nil
+}
+
+func TestServiceConfigDialOptions(t *testing.T) {
+	t.Parallel()
+
+	const retryServiceConfig = `{
+		"methodConfig": [{
+			"name": [{"service": "grpc.service.apiservice.APIService"}],
+			"retryPolicy": {
+				"maxAttempts": 3,
+				"initialBackoff": "0.01s",
+				"maxBackoff": "0.01s",
+				"backoffMultiplier": 1,
+				"retryableStatusCodes": ["UNAVAILABLE"]
+			}
+		}]
+	}`
+
+	testcases := []struct {
+		name          string
+		serviceConfig string
+		wantCode      codes.Code
+		wantCalls     int32
+	}{
+		{
+			name:      "no service config",
+			wantCode:  codes.Unavailable,
+			wantCalls: 1,
+		},
+		{
+			name:          "retry policy",
+			serviceConfig: retryServiceConfig,
+			wantCode:      codes.OK,
+			wantCalls:     2,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			lis, err := net.Listen("tcp", "127.0.0.1:0")
+			if err != nil {
+				t.Errorf("failed to listen: %v", err)
+				return
+			}
+			srv := &flakyAPIServer{}
+			server := grpc.NewServer()
+			apiservice.RegisterAPIServiceServer(server, srv)
+			go server.Serve(lis) //nolint:errcheck
+			defer server.Stop()
+
+			options := append(
+				[]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())},
+				serviceConfigDialOptions(tc.serviceConfig)...,
+			)
+			conn, err := grpc.NewClient(lis.Addr().String(), options...)
+			if err != nil {
+				t.Errorf("failed to create client: %v", err)
+				return
+			}
+			defer conn.Close()
+
+			_, err = apiservice.NewAPIServiceClient(conn).GetPiped(context.Background(), &apiservice.GetPipedRequest{PipedId: "test_piped_id"})
+			if got := status.Code(err); got != tc.wantCode {
+				t.Errorf("expected code %s, got %v", tc.wantCode, err)
+			}
+			if got := srv.calls.Load(); got != tc.wantCalls {
+				t.Errorf("expected %d calls, got %d", tc.wantCalls, got)
+			}
+		})
+	}
+}
+
+// authAPIServer returns the authorization metadata sent with GetPiped as the piped description.
+type authAPIServer struct {
+	apiservice.UnimplementedAPIServiceServer
+}
+
+func (s *authAPIServer) GetPiped(ctx context.Context, req *apiservice.GetPipedRequest) (*apiservice.GetPipedResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return &apiservice.GetPipedResponse{Piped: &model.Piped{Id: req.PipedId, Desc: strings.Join(md.Get("authorization"), ",")}}, nil
+}
+
+func TestNewAPIClientInsecure(t *testing.T) {
+	t.Parallel()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Errorf("failed to listen: %v", err)
+		return
+	}
+	server := grpc.NewServer()
+	apiservice.RegisterAPIServiceServer(server, &authAPIServer{})
+	go server.Serve(lis) //nolint:errcheck
+	defer server.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	cfg := resolvedConfig{host: lis.Addr().String(), apiKey: "test-key", insecure: true}
+	client, err := newAPIClient(ctx, cfg, nil, "test-trace-id")
+	if err != nil {
+		t.Errorf("failed to create client: %v", err)
+		return
+	}
+
+	resp, err := client.GetPiped(ctx, &apiservice.GetPipedRequest{PipedId: "test_piped_id"})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if got, want := resp.Piped.Desc, "API-KEY test-key"; got != want {
+		t.Errorf("expected authorization %q, got %q", want, got)
+	}
+}
+
+func TestHostDialOptions(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		host        string
+		wantTarget  string
+		wantOptions int
+		wantErr     bool
+	}{
+		{
+			name:       "host and port",
+			host:       "pipecd.example.com:443",
+			wantTarget: "pipecd.example.com:443",
+		},
+		{
+			name:        "unix socket",
+			host:        "unix:///var/run/pipecd.sock",
+			wantTarget:  "passthrough:////var/run/pipecd.sock",
+			wantOptions: 1,
+		},
+		{
+			name:       "scheme resolved by gRPC",
+			host:       "dns:///pipecd.example.com:443",
+			wantTarget: "dns:///pipecd.example.com:443",
+		},
+		{
+			name:    "unix socket without path",
+			host:    "unix://",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported scheme",
+			host:    "https://pipecd.example.com",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			target, options, err := hostDialOptions(tc.host)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("expected an error, got target %q", target)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if target != tc.wantTarget {
+				t.Errorf("expected target %q, got %q", tc.wantTarget, target)
+			}
+			if len(options) != tc.wantOptions {
+				t.Errorf("expected %d dial options, got %d", tc.wantOptions, len(options))
+			}
+		})
+	}
+}
+
+func TestNewAPIClientUnixSocket(t *testing.T) {
+	t.Parallel()
+
+	socket := filepath.Join(t.TempDir(), "pipecd.sock")
+	lis, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Errorf("failed to listen: %v", err)
+		return
+	}
+	server := grpc.NewServer()
+	apiservice.RegisterAPIServiceServer(server, &authAPIServer{})
+	go server.Serve(lis) //nolint:errcheck
+	defer server.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	cfg := resolvedConfig{host: "unix://" + socket, apiKey: "test-key", insecure: true}
+	client, err := newAPIClient(ctx, cfg, nil, "test-trace-id")
+	if err != nil {
+		t.Errorf("failed to create client: %v", err)
+		return
+	}
+
+	resp, err := client.GetPiped(ctx, &apiservice.GetPipedRequest{PipedId: "test_piped_id"})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if got, want := resp.Piped.Desc, "API-KEY test-key"; got != want {
+		t.Errorf("expected authorization %q, got %q", want, got)
+	}
+}
+
+// writeTestCertificate writes a self-signed certificate for 127.0.0.1 to a PEM file in dir,
+// returning the path of the file and the certificate to serve.
+func writeTestCertificate(dir string) (string, tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", tls.Certificate{}, err
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return "", tls.Certificate{}, err
+	}
+	certFile := filepath.Join(dir, "ca.pem")
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		return "", tls.Certificate{}, err
+	}
+	return certFile, tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, nil
+}
+
+func TestNewTLSConfig(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	certFile, cert, err := writeTestCertificate(dir)
+	if err != nil {
+		t.Errorf("failed to write certificate: %v", err)
+		return
+	}
+	invalidFile := filepath.Join(dir, "invalid.pem")
+	if err := os.WriteFile(invalidFile, []byte("not a certificate"), 0o600); err != nil {
+		t.Errorf("failed to write file: %v", err)
+		return
+	}
+
+	x509Cert, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		t.Errorf("failed to parse certificate: %v", err)
+		return
+	}
+	pool := x509.NewCertPool()
+	pool.AddCert(x509Cert)
+
+	testcases := []struct {
+		name               string
+		caCertFile         string
+		insecureSkipVerify bool
+		wantRootCAs        *x509.CertPool
+		wantErr            bool
+	}{
+		{
+			name: "system CAs",
+		},
+		{
+			name:        "ca cert file",
+			caCertFile:  certFile,
+			wantRootCAs: pool,
+		},
+		{
+			name:               "skip verify",
+			insecureSkipVerify: true,
+		},
+		{
+			name:       "missing file",
+			caCertFile: filepath.Join(dir, "missing.pem"),
+			wantErr:    true,
+		},
+		{
+			name:       "no certificate in file",
+			caCertFile: invalidFile,
+			wantErr:    true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := newTLSConfig(tc.caCertFile, tc.insecureSkipVerify)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("expected error %t, got %v", tc.wantErr, err)
+				return
+			}
+			if tc.wantErr {
+				return
+			}
+			if got.InsecureSkipVerify != tc.insecureSkipVerify {
+				t.Errorf("expected InsecureSkipVerify %t, got %t", tc.insecureSkipVerify, got.InsecureSkipVerify)
+			}
+			if (tc.wantRootCAs == nil && got.RootCAs != nil) || (tc.wantRootCAs != nil && !tc.wantRootCAs.Equal(got.RootCAs)) {
+				t.Errorf("unexpected root CAs")
+			}
+		})
+	}
+}
+
+func TestNewAPIClientPrivateCA(t *testing.T) {
+	t.Parallel()
+
+	certFile, cert, err := writeTestCertificate(t.TempDir())
+	if err != nil {
+		t.Errorf("failed to write certificate: %v", err)
+		return
+	}
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Errorf("failed to listen: %v", err)
+		return
+	}
+	server := grpc.NewServer(grpc.Creds(credentials.NewServerTLSFromCert(&cert)))
+	apiservice.RegisterAPIServiceServer(server, &authAPIServer{})
+	go server.Serve(lis) //nolint:errcheck
+	defer server.Stop()
+
+	tlsConfig, err := newTLSConfig(certFile, false)
+	if err != nil {
+		t.Errorf("failed to create TLS config: %v", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	cfg := resolvedConfig{host: lis.Addr().String(), apiKey: "test-key"}
+	client, err := newAPIClient(ctx, cfg, tlsConfig, "test-trace-id")
+	if err != nil {
+		t.Errorf("failed to create client: %v", err)
+		return
+	}
+	if _, err := client.GetPiped(ctx, &apiservice.GetPipedRequest{PipedId: "test_piped_id"}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestAccProviderDialTimeout(t *testing.T) {
+	t.Parallel()
+
+	// Nothing listens on the address once the listener is closed.
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Errorf("failed to listen: %v", err)
+		return
+	}
+	addr := lis.Addr().String()
+	lis.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pipecd": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "pipecd" {
+  host         = "%s"
+  api_key      = "test"
+  dial_timeout = "200ms"
+}
+
+data "pipecd_provider_config" "test" {}
+`, addr),
+				ExpectError: regexp.MustCompile(`(?s)could not connect to PipeCD at\s+` + regexp.QuoteMeta(addr) + `\s+within\s+200ms`),
+			},
+		},
+	})
+}
+
+func TestConfigureSharesAPIClient(t *testing.T) {
+	t.Parallel()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Errorf("failed to listen: %v", err)
+		return
+	}
+	server := grpc.NewServer()
+	apiservice.RegisterAPIServiceServer(server, &authAPIServer{})
+	go server.Serve(lis) //nolint:errcheck
+	defer server.Stop()
+
+	configure := func(apiKey string) APIClient {
+		ctx := context.Background()
+		p := New("test")()
+
+		var schemaResp fwprovider.SchemaResponse
+		p.Schema(ctx, fwprovider.SchemaRequest{}, &schemaResp)
+		objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+		values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+		for name, typ := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(typ, nil)
+		}
+		values["host"] = tftypes.NewValue(tftypes.String, lis.Addr().String())
+		values["api_key"] = tftypes.NewValue(tftypes.String, apiKey)
+		values["insecure"] = tftypes.NewValue(tftypes.Bool, true)
+
+		req := fwprovider.ConfigureRequest{
+			Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+		}
+		var resp fwprovider.ConfigureResponse
+		p.Configure(ctx, req, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Errorf("unexpected errors: %v", resp.Diagnostics)
+			return nil
+		}
+		return resp.ResourceData.(*providerData).c
+	}
+
+	first := configure("test-key")
+	second := configure("test-key")
+	other := configure("other-key")
+	if first == nil || second == nil || other == nil {
+		return
+	}
+	if first != second {
+		t.Errorf("expected provider instances configured with the same API key to share the API client")
+	}
+	if first == other {
+		t.Errorf("expected provider instances configured with different API keys not to share the API client")
+	}
+}
@@ -16,10 +16,18 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -34,6 +42,7 @@ import (
 var (
 	_ resource.Resource                = &ApplicationResource{}
 	_ resource.ResourceWithImportState = &ApplicationResource{}
+	_ resource.ResourceWithModifyPlan  = &ApplicationResource{}
 )
 
 func NewApplicationResource() resource.Resource {
@@ -41,57 +50,126 @@ func NewApplicationResource() resource.Resource {
 }
 
 type ApplicationResource struct {
-	c APIClient
+	c              APIClient
+	strict         bool
+	debug          bool
+	defaultPipedID string
 }
 
 type (
 	applicationResourceModel struct {
-		ID               types.String                `tfsdk:"id"`
-		Name             types.String                `tfsdk:"name"`
-		PipedID          types.String                `tfsdk:"piped_id"`
-		Kind             types.String                `tfsdk:"kind"`
-		PlatformProvider types.String                `tfsdk:"platform_provider"`
-		Description      types.String                `tfsdk:"description"`
-		Git              applicationResourceGitModel `tfsdk:"git"`
+		ID               types.String                 `tfsdk:"id"`
+		Name             types.String                 `tfsdk:"name"`
+		PipedID          types.String                 `tfsdk:"piped_id"`
+		Kind             types.String                 `tfsdk:"kind"`
+		PlatformProvider types.String                 `tfsdk:"platform_provider"`
+		Description      types.String                 `tfsdk:"description"`
+		Git              *applicationResourceGitModel `tfsdk:"git"`
+		Labels           types.Map                    `tfsdk:"labels"`
+		Enabled          types.Bool                   `tfsdk:"enabled"`
 	}
 
 	applicationResourceGitModel struct {
 		RepositoryID types.String `tfsdk:"repository_id"`
+		Remote       types.String `tfsdk:"remote"`
+		Branch       types.String `tfsdk:"branch"`
 		Path         types.String `tfsdk:"path"`
 		Filename     types.String `tfsdk:"filename"`
 	}
 )
 
+// ImportState imports an application by its ID, or by its name optionally prefixed with its project as project/name.
 func (a *ApplicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	appID, diags := a.resolveImportID(ctx, req.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	getReq := &api.GetApplicationRequest{
-		ApplicationId: req.ID,
+		ApplicationId: appID,
+	}
+	var getResp *api.GetApplicationResponse
+	err := retryOnNotFound(ctx, importRetryAttempts, importRetryInterval, func(ctx context.Context) error {
+		var err error
+		getResp, err = a.c.GetApplication(ctx, getReq)
+		return err
+	})
+	if isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Application not found",
+			"The application "+appID+" was not found. Make sure the API key belongs to the project of the application.",
+		)
+		return
 	}
-	getResp, err := a.c.GetApplication(ctx, getReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading application",
-			"Could not read application, unexpected error: "+err.Error(),
+			"Could not read application, unexpected error: "+errorDetail(err, a.debug),
 		)
 		return
 	}
 
-	state := applicationResourceModel{
-		ID:               types.StringValue(req.ID),
-		Name:             types.StringValue(getResp.Application.Name),
-		PipedID:          types.StringValue(getResp.Application.PipedId),
-		Kind:             types.StringValue(getResp.Application.Kind.String()),
-		PlatformProvider: types.StringValue(getResp.Application.PlatformProvider),
-		Description:      types.StringValue(getResp.Application.Description),
-		Git: applicationResourceGitModel{
-			RepositoryID: types.StringValue(getResp.Application.GitPath.Repo.Id),
-			Path:         types.StringValue(getResp.Application.GitPath.Path),
-			Filename:     types.StringValue(getResp.Application.GitPath.ConfigFilename),
-		},
-	}
-	diags := resp.State.Set(ctx, &state)
+	state, diags := newApplicationResourceModel(appID, getResp.Application, nil)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
 
+// resolveImportID returns the ID of the application to import.
+// PipeCD generates UUIDs as application IDs, so any other import ID is taken as a name, or a project/name pair.
+func (a *ApplicationResource) resolveImportID(ctx context.Context, importID string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if _, err := uuid.Parse(importID); err == nil {
+		return importID, diags
+	}
+	projectID, name, ok := strings.Cut(importID, "/")
+	if !ok {
+		projectID, name = "", importID
+	}
+
+	// ListApplications returns either enabled or disabled applications.
+	var apps []*model.Application
+	for _, disabled := range []bool{false, true} {
+		found, err := listApplications(ctx, a.c, &api.ListApplicationsRequest{Name: name, Disabled: disabled})
+		if err != nil {
+			diags.AddError(
+				"Error listing applications",
+				fmt.Sprintf("Could not list applications named %q, unexpected error: %s", name, errorDetail(err, a.debug)),
+			)
+			return "", diags
+		}
+		for _, app := range found {
+			if projectID == "" || app.ProjectId == projectID {
+				apps = append(apps, app)
+			}
+		}
+	}
+
+	switch len(apps) {
+	case 0:
+		diags.AddError(
+			"Application not found",
+			fmt.Sprintf("No application named %q was found in the project of the API key. "+
+				"The import ID must be the application ID, its name, or its project and name as project/name.", name),
+		)
+		return "", diags
+	case 1:
+		return apps[0].Id, diags
+	}
+	ids := make([]string, 0, len(apps))
+	for _, app := range apps {
+		ids = append(ids, app.Id)
+	}
+	diags.AddError(
+		"Multiple applications found",
+		fmt.Sprintf("%d applications are named %q, so import the application by its ID instead, one of: %s.",
+			len(apps), name, strings.Join(ids, ", ")),
+	)
+	return "", diags
+}
+
 func (a *ApplicationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_application"
 }
@@ -114,10 +192,14 @@ func (a *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					nameValidator{},
+				},
 			},
 			"piped_id": schema.StringAttribute{
-				Description: "The ID of piped that should handle this application.",
-				Required:    true,
+				Description: "The ID of piped that should handle this application. Defaults to default_piped_id of the provider configuration.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"kind": schema.StringAttribute{
 				Description: "The kind of application.",
@@ -136,14 +218,18 @@ func (a *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				},
 			},
 			"platform_provider": schema.StringAttribute{
-				Description: "The platform provider name. One of the registered providers in the piped configuration. The previous name of this field is cloud-provider.",
-				Required:    true,
+				Description: "The platform provider name. One of the registered providers in the piped configuration. The previous name of this field is cloud-provider. " +
+					"In strict mode the provider must be registered in the piped with a known platform provider type.",
+				Required: true,
 			},
 			"description": schema.StringAttribute{
-				Description: "The description of the application.",
-				Optional:    true,
-				Computed:    true,
+				Description: "The description of the application. A single trailing newline, as added by heredoc strings, is trimmed before it is sent to PipeCD. " +
+					"Changing it replaces the application, as the PipeCD API cannot update the description of an existing application.",
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
+					// Keep an unset description known, so that it does not replace the application on every change.
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -158,6 +244,20 @@ func (a *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 							stringplanmodifier.RequiresReplace(),
 						},
 					},
+					"remote": schema.StringAttribute{
+						Description: "The remote URL of the repository. This is taken from the repository registered in the piped configuration.",
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"branch": schema.StringAttribute{
+						Description: "The branch the application is deployed from. This is taken from the repository registered in the piped configuration.",
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
 					"path": schema.StringAttribute{
 						Description: "The relative path from the root of repository to the application directory.",
 						Required:    true,
@@ -166,24 +266,42 @@ func (a *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 						},
 					},
 					"filename": schema.StringAttribute{
-						Description: "The configuration file name. (default \"app.pipecd.yaml\")",
+						Description: "The configuration file name. (default \"" + model.DefaultApplicationConfigFilename + "\")",
 						Optional:    true,
 						Computed:    true,
-						Default:     stringdefault.StaticString("app.pipecd.yaml"),
+						Default:     stringdefault.StaticString(model.DefaultApplicationConfigFilename),
 					},
 				},
 			},
+			"labels": schema.MapAttribute{
+				Description: "The labels of the application. Read-only: PipeCD takes them from the application configuration file in Git, " +
+					"and its API cannot set them.",
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the application is enabled. Disabled applications are kept in PipeCD but not deployed. (default true)",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
 		},
 	}
 }
 
 func (a *applicationResourceModel) application() *model.Application {
-	git := &model.ApplicationGitPath{
-		Repo: &model.ApplicationGitRepository{
-			Id: a.Git.RepositoryID.ValueString(),
-		},
-		Path:           a.Git.Path.ValueString(),
-		ConfigFilename: a.Git.Filename.ValueString(),
+	var git *model.ApplicationGitPath
+	if a.Git != nil {
+		git = &model.ApplicationGitPath{
+			Repo: &model.ApplicationGitRepository{
+				Id: a.Git.RepositoryID.ValueString(),
+			},
+			Path:           a.Git.Path.ValueString(),
+			ConfigFilename: a.Git.Filename.ValueString(),
+		}
 	}
 	kind := model.ApplicationKind_value[a.Kind.ValueString()]
 	app := &model.Application{
@@ -193,11 +311,100 @@ func (a *applicationResourceModel) application() *model.Application {
 		GitPath:          git,
 		Kind:             model.ApplicationKind(kind),
 		PlatformProvider: a.PlatformProvider.ValueString(),
-		Description:      a.Description.ValueString(),
+		Description:      normalizeDescription(a.Description.ValueString()),
 	}
 	return app
 }
 
+// newApplicationResourceModel converts the application appID returned by PipeCD into the resource state.
+// prior is the planned or prior state, if any, whose configured values are kept where PipeCD returns them normalized or not at all.
+func newApplicationResourceModel(appID string, app *model.Application, prior *applicationResourceModel) (applicationResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if app.GitPath == nil {
+		diags.Append(missingGitPathWarning(appID))
+	}
+	kind, d := enumValue(path.Root("kind"), app.Kind)
+	diags.Append(d...)
+
+	description := types.StringNull()
+	var git *applicationResourceGitModel
+	if prior != nil {
+		description = prior.Description
+		git = prior.Git
+	}
+	return applicationResourceModel{
+		ID:               types.StringValue(appID),
+		Name:             types.StringValue(app.Name),
+		PipedID:          types.StringValue(app.PipedId),
+		Kind:             kind,
+		PlatformProvider: types.StringValue(app.PlatformProvider),
+		Description:      descriptionValue(description, app.Description),
+		Git:              applicationResourceGit(app.GitPath, git),
+		Labels:           labelsValue(app.Labels),
+		Enabled:          types.BoolValue(!app.Disabled),
+	}, diags
+}
+
+// applicationResourceGit converts the Git path returned by PipeCD into the git attribute.
+// When PipeCD returns no Git path, the given fallback is kept with its computed attributes set to null,
+// since git cannot be null in the configuration.
+func applicationResourceGit(gitPath *model.ApplicationGitPath, fallback *applicationResourceGitModel) *applicationResourceGitModel {
+	if gitPath == nil {
+		if fallback == nil {
+			return nil
+		}
+		git := *fallback
+		git.Remote = types.StringNull()
+		git.Branch = types.StringNull()
+		return &git
+	}
+	// An empty file name returned by PipeCD is read as the default one, so that it does not conflict with the default of filename.
+	filename := gitPath.ConfigFilename
+	if filename == "" {
+		filename = model.DefaultApplicationConfigFilename
+	}
+	return &applicationResourceGitModel{
+		RepositoryID: types.StringValue(gitPath.GetRepo().GetId()),
+		Remote:       types.StringValue(gitPath.GetRepo().GetRemote()),
+		Branch:       types.StringValue(gitPath.GetRepo().GetBranch()),
+		Path:         types.StringValue(gitPath.Path),
+		Filename:     types.StringValue(filename),
+	}
+}
+
+// missingGitPathWarning is reported when PipeCD returns an application without its Git path.
+func missingGitPathWarning(appID string) diag.Diagnostic {
+	return diag.NewAttributeWarningDiagnostic(
+		path.Root("git"),
+		"Missing Git path",
+		"PipeCD returned the application "+appID+" without its Git path, so the git attribute could not be read from PipeCD.",
+	)
+}
+
+// labelsValue converts the labels returned by PipeCD into the labels attribute, which is never null.
+func labelsValue(labels map[string]string) types.Map {
+	elems := make(map[string]attr.Value, len(labels))
+	for k, v := range labels {
+		elems[k] = types.StringValue(v)
+	}
+	return types.MapValueMust(types.StringType, elems)
+}
+
+// normalizeDescription trims a single trailing newline, which is always present in heredoc strings.
+func normalizeDescription(desc string) string {
+	return strings.TrimSuffix(desc, "\n")
+}
+
+// descriptionValue returns the description to be stored in state.
+// The configured value is kept when it only differs from the server one by the trimmed trailing newline.
+func descriptionValue(configured types.String, server string) types.String {
+	if !configured.IsNull() && !configured.IsUnknown() && normalizeDescription(configured.ValueString()) == server {
+		return configured
+	}
+	return types.StringValue(server)
+}
+
 func (a *ApplicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan applicationResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -216,15 +423,43 @@ func (a *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 		Description:      app.Description,
 	}
 
+	resp.Diagnostics.Append(checkPipedEnabled(ctx, a.c, app.PipedId)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	addResp, err := a.c.AddApplication(ctx, addReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating application",
-			"Could not create application, unexpected error: "+err.Error(),
+			"Could not create application, unexpected error: "+errorDetail(err, a.debug),
 		)
 		return
 	}
 
+	// Record the application before reading it back, so that it is tracked even if the read fails.
+	// Applications are enabled when added.
+	enabled := plan.Enabled
+	plan.ID = types.StringValue(addResp.ApplicationId)
+	plan.Enabled = types.BoolValue(true)
+	created := createdApplicationResourceModel(plan, app)
+	diags = resp.State.Set(ctx, &created)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !enabled.ValueBool() {
+		if _, err := a.c.DisableApplication(ctx, &api.DisableApplicationRequest{ApplicationId: addResp.ApplicationId}); err != nil {
+			resp.Diagnostics.AddError(
+				"Error disabling application",
+				"The application "+addResp.ApplicationId+" was created, but could not be disabled, unexpected error: "+errorDetail(err, a.debug)+"\n\n"+
+					"The application has been recorded in the Terraform state as tainted, so the next apply replaces it.",
+			)
+			return
+		}
+	}
+
 	getReq := &api.GetApplicationRequest{
 		ApplicationId: addResp.ApplicationId,
 	}
@@ -232,30 +467,33 @@ func (a *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error getting application",
-			"Could not get application, unexpected error: "+err.Error(),
+			"The application "+addResp.ApplicationId+" was created, but could not be read back, unexpected error: "+errorDetail(err, a.debug)+"\n\n"+
+				"The application has been recorded in the Terraform state as tainted, so the next apply replaces it.",
 		)
 		return
 	}
 
 	tflog.Debug(ctx, "AddApplication response", map[string]interface{}{"response_fields": getResp})
 
-	state := applicationResourceModel{
-		ID:               types.StringValue(addResp.ApplicationId),
-		Name:             types.StringValue(getResp.Application.Name),
-		PipedID:          types.StringValue(getResp.Application.PipedId),
-		Kind:             types.StringValue(getResp.Application.Kind.String()),
-		PlatformProvider: types.StringValue(getResp.Application.PlatformProvider),
-		Description:      types.StringValue(getResp.Application.Description),
-		Git: applicationResourceGitModel{
-			RepositoryID: types.StringValue(getResp.Application.GitPath.Repo.Id),
-			Path:         types.StringValue(getResp.Application.GitPath.Path),
-			Filename:     types.StringValue(getResp.Application.GitPath.ConfigFilename),
-		},
-	}
+	state, diags := newApplicationResourceModel(addResp.ApplicationId, getResp.Application, &plan)
+	resp.Diagnostics.Append(diags...)
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
 
+// createdApplicationResourceModel returns the state recorded for a created application before it is read back.
+// The computed values still unknown in the plan are not allowed in state, so they are taken from the added application or set to null.
+func createdApplicationResourceModel(plan applicationResourceModel, app *model.Application) applicationResourceModel {
+	if plan.Description.IsUnknown() {
+		plan.Description = types.StringValue(app.Description)
+	}
+	if plan.Labels.IsUnknown() {
+		plan.Labels = types.MapNull(types.StringType)
+	}
+	plan.Git = applicationResourceGit(nil, plan.Git)
+	return plan
+}
+
 func (a *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state applicationResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -264,6 +502,25 @@ func (a *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	getReq := &api.GetApplicationRequest{
+		ApplicationId: state.ID.ValueString(),
+	}
+	getResp, err := a.c.GetApplication(ctx, getReq)
+	if isNotFound(err) {
+		// The application was deleted outside of Terraform, so let Terraform create it again.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading application",
+			"Could not read application "+state.ID.ValueString()+", unexpected error: "+errorDetail(err, a.debug),
+		)
+		return
+	}
+
+	state, diags = newApplicationResourceModel(state.ID.ValueString(), getResp.Application, &state)
+	resp.Diagnostics.Append(diags...)
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
@@ -284,12 +541,240 @@ func (a *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 	if _, err := a.c.UpdateApplication(ctx, updateReq); err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating application",
-			"Could not update application, unexpected error: "+err.Error(),
+			"Could not update application, unexpected error: "+errorDetail(err, a.debug),
 		)
 		return
 	}
-	diags = resp.State.Set(ctx, &plan)
+
+	var state applicationResourceModel
+	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !plan.Enabled.Equal(state.Enabled) {
+		resp.Diagnostics.Append(a.setEnabled(ctx, plan.ID.ValueString(), plan.Enabled.ValueBool())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Read the application back, so that the computed attributes are not left stale.
+	getResp, err := a.c.GetApplication(ctx, &api.GetApplicationRequest{ApplicationId: plan.ID.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting application",
+			"The application "+plan.ID.ValueString()+" was updated, but could not be read back, unexpected error: "+errorDetail(err, a.debug),
+		)
+		return
+	}
+
+	state, diags = newApplicationResourceModel(plan.ID.ValueString(), getResp.Application, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// setEnabled enables or disables the given application.
+func (a *ApplicationResource) setEnabled(ctx context.Context, appID string, enabled bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var err error
+	action := "enable"
+	if enabled {
+		_, err = a.c.EnableApplication(ctx, &api.EnableApplicationRequest{ApplicationId: appID})
+	} else {
+		action = "disable"
+		_, err = a.c.DisableApplication(ctx, &api.DisableApplicationRequest{ApplicationId: appID})
+	}
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("enabled"),
+			"Error updating application",
+			"Could not "+action+" application "+appID+", unexpected error: "+errorDetail(err, a.debug),
+		)
+	}
+	return diags
+}
+
+func (a *ApplicationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the resource is being destroyed or the provider is not configured yet.
+	if req.Plan.Raw.IsNull() || a.c == nil {
+		return
+	}
+
+	var plan applicationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var configPipedID types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("piped_id"), &configPipedID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if configPipedID.IsNull() {
+		if a.defaultPipedID == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("piped_id"),
+				"Missing piped_id",
+				"The piped_id must be set on the application or as default_piped_id in the provider configuration.",
+			)
+			return
+		}
+		plan.PipedID = types.StringValue(a.defaultPipedID)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("piped_id"), plan.PipedID)...)
+	}
+
+	var state applicationResourceModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// In strict mode the platform provider is checked whenever the application is created or
+	// its piped or platform provider is changed.
+	if a.strict && !plan.PipedID.IsUnknown() && !plan.PlatformProvider.IsUnknown() &&
+		(req.State.Raw.IsNull() || !plan.PipedID.Equal(state.PipedID) || !plan.PlatformProvider.Equal(state.PlatformProvider)) {
+		resp.Diagnostics.Append(checkPlatformProvider(ctx, a.c, plan.PipedID.ValueString(), plan.PlatformProvider.ValueString(), a.debug)...)
+	}
+
+	// The piped is only checked when an existing application is moved to another one.
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	if plan.PipedID.IsUnknown() || plan.PipedID.Equal(state.PipedID) {
+		return
+	}
+	resp.Diagnostics.Append(checkPipedOnline(ctx, a.c, plan.PipedID.ValueString(), a.strict, a.debug)...)
+}
+
+// checkPipedOnline reports a diagnostic for piped_id when the given piped is not connected to the control plane.
+// The diagnostic is a warning unless strict is set.
+func checkPipedOnline(ctx context.Context, c APIClient, pipedID string, strict, debug bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	getResp, err := c.GetPiped(ctx, &api.GetPipedRequest{PipedId: pipedID})
+	if isNotFound(err) {
+		// Let users still manage or delete applications whose piped was removed.
+		diags.AddAttributeWarning(
+			path.Root("piped_id"),
+			"Piped not found",
+			"The piped "+pipedID+" was not found. It may have been removed from the control plane.",
+		)
+		return diags
+	}
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("piped_id"),
+			"Error reading piped",
+			"Could not read piped "+pipedID+", unexpected error: "+errorDetail(err, debug),
+		)
+		return diags
+	}
+
+	if getResp.Piped.Status == model.Piped_ONLINE {
+		return diags
+	}
+
+	summary := "Piped is not online"
+	detail := fmt.Sprintf("The piped %q (%s) is currently %s. "+
+		"The application will not be deployed until the piped is running and connected to the control plane.",
+		getResp.Piped.Name, pipedID, getResp.Piped.Status)
+	if strict {
+		diags.AddAttributeError(path.Root("piped_id"), summary, detail)
+	} else {
+		diags.AddAttributeWarning(path.Root("piped_id"), summary, detail)
+	}
+	return diags
+}
+
+// checkPipedEnabled reports an error for piped_id when the given piped is disabled.
+// PipeCD accepts applications for disabled pipeds, but they are never deployed.
+// It is checked on create rather than on plan, since the piped may be registered in the same apply.
+// Other errors are left to the following API calls to report.
+func checkPipedEnabled(ctx context.Context, c APIClient, pipedID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	getResp, err := c.GetPiped(ctx, &api.GetPipedRequest{PipedId: pipedID})
+	if err != nil || !getResp.Piped.Disabled {
+		return diags
+	}
+
+	diags.AddAttributeError(
+		path.Root("piped_id"),
+		"Piped is disabled",
+		fmt.Sprintf("The piped %q (%s) is disabled, so it would never deploy the application. "+
+			"Enable the piped in the PipeCD web console before creating applications for it.",
+			getResp.Piped.Name, pipedID),
+	)
+	return diags
+}
+
+// knownPlatformProviderTypes are the platform provider types supported by PipeCD.
+var knownPlatformProviderTypes = []model.PlatformProviderType{
+	model.PlatformProviderKubernetes,
+	model.PlatformProviderTerraform,
+	model.PlatformProviderLambda,
+	model.PlatformProviderCloudRun,
+	model.PlatformProviderECS,
+}
+
+// checkPlatformProvider reports an error for platform_provider when the given provider is not registered
+// in the piped or its type is not a known PipeCD platform provider type.
+// Pipeds that are not found are left to checkPipedOnline.
+func checkPlatformProvider(ctx context.Context, c APIClient, pipedID, name string, debug bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	getResp, err := c.GetPiped(ctx, &api.GetPipedRequest{PipedId: pipedID})
+	if isNotFound(err) {
+		return diags
+	}
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("piped_id"),
+			"Error reading piped",
+			"Could not read piped "+pipedID+", unexpected error: "+errorDetail(err, debug),
+		)
+		return diags
+	}
+
+	var provider *model.Piped_PlatformProvider
+	for _, p := range getResp.Piped.GetPlatformProviders() {
+		if p.Name == name {
+			provider = p
+			break
+		}
+	}
+	if provider == nil {
+		diags.AddAttributeError(
+			path.Root("platform_provider"),
+			"Unknown platform provider",
+			fmt.Sprintf("The platform provider %q is not registered in the piped %q (%s).", name, getResp.Piped.Name, pipedID),
+		)
+		return diags
+	}
+
+	for _, t := range knownPlatformProviderTypes {
+		if provider.Type == t.String() {
+			return diags
+		}
+	}
+	known := make([]string, 0, len(knownPlatformProviderTypes))
+	for _, t := range knownPlatformProviderTypes {
+		known = append(known, t.String())
+	}
+	diags.AddAttributeError(
+		path.Root("platform_provider"),
+		"Unknown platform provider type",
+		fmt.Sprintf("The platform provider %q of the piped %q (%s) has the unknown type %q. Known types are: %s.",
+			name, getResp.Piped.Name, pipedID, provider.Type, strings.Join(known, ", ")),
+	)
+	return diags
 }
 
 func (a *ApplicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -307,7 +792,7 @@ func (a *ApplicationResource) Delete(ctx context.Context, req resource.DeleteReq
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting PipeCD application",
-			"Could not delete application, unexpected error: "+err.Error(),
+			"Could not delete application, unexpected error: "+errorDetail(err, a.debug),
 		)
 		return
 	}
@@ -318,5 +803,9 @@ func (a *ApplicationResource) Configure(_ context.Context, req resource.Configur
 		return
 	}
 
-	a.c = req.ProviderData.(APIClient)
+	data := req.ProviderData.(*providerData)
+	a.c = data.c
+	a.strict = data.strict
+	a.debug = data.debug
+	a.defaultPipedID = data.defaultPipedID
 }
@@ -0,0 +1,268 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	api "github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+var (
+	_ resource.Resource               = &ApplicationSyncResource{}
+	_ resource.ResourceWithModifyPlan = &ApplicationSyncResource{}
+)
+
+const (
+	defaultApplicationSyncWaitTimeout = "5m"
+	commandPollInterval               = 10 * time.Second
+)
+
+func NewApplicationSyncResource() resource.Resource {
+	return &ApplicationSyncResource{}
+}
+
+// ApplicationSyncResource triggers a sync of an application when it is created or its triggers change.
+type ApplicationSyncResource struct {
+	c     APIClient
+	debug bool
+}
+
+type applicationSyncResourceModel struct {
+	ApplicationID types.String `tfsdk:"application_id"`
+	Triggers      types.Map    `tfsdk:"triggers"`
+	Wait          types.Bool   `tfsdk:"wait"`
+	WaitTimeout   types.String `tfsdk:"wait_timeout"`
+	CommandID     types.String `tfsdk:"command_id"`
+}
+
+func (s *ApplicationSyncResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_sync"
+}
+
+func (s *ApplicationSyncResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "PipeCD application sync resource. It triggers a sync of the application when created " +
+			"and whenever its triggers change, with the `AUTO` sync strategy. Destroying it only removes it from the state.",
+
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Description: "The ID of the application to sync.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values whose changes trigger a new sync, e.g. the ID of the infrastructure the application depends on.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"wait": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait until the piped handled the sync command. " +
+					"The `command_id` is recorded before waiting, so a sync that fails on create is only triggered again by replacing the tainted resource.",
+				Optional: true,
+			},
+			"wait_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to wait for the sync command to be handled when wait is set. (default \"" + defaultApplicationSyncWaitTimeout + "\")",
+				Optional:            true,
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"command_id": schema.StringAttribute{
+				Description: "The ID of the command of the last triggered sync.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (s *ApplicationSyncResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan applicationSyncResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.CommandID, diags = s.sync(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Record the command before waiting for it, so that the triggered sync is tracked even if the wait fails.
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(s.wait(ctx, plan)...)
+}
+
+// Read keeps the state as is, as a triggered sync has nothing to refresh.
+func (s *ApplicationSyncResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state applicationSyncResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update triggers a new sync when the triggers changed, and only records the other changes.
+func (s *ApplicationSyncResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan applicationSyncResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	triggered := plan.CommandID.IsUnknown()
+	if triggered {
+		plan.CommandID, diags = s.sync(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Record the command before waiting for it, so that the triggered sync is tracked even if the wait fails.
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !triggered {
+		return
+	}
+	resp.Diagnostics.Append(s.wait(ctx, plan)...)
+}
+
+// Delete only removes the resource from the state, as a triggered sync cannot be undone.
+func (s *ApplicationSyncResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+func (s *ApplicationSyncResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the resource is being created or destroyed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state applicationSyncResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Triggers.Equal(state.Triggers) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("command_id"), types.StringUnknown())...)
+	}
+}
+
+func (s *ApplicationSyncResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	s.c = data.c
+	s.debug = data.debug
+}
+
+// sync triggers a sync of the planned application and returns the ID of its command.
+func (s *ApplicationSyncResource) sync(ctx context.Context, plan applicationSyncResourceModel) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	appID := plan.ApplicationID.ValueString()
+	syncResp, err := s.c.SyncApplication(ctx, &api.SyncApplicationRequest{ApplicationId: appID})
+	if err != nil {
+		diags.AddError(
+			"Error syncing application",
+			"Could not sync application "+appID+", unexpected error: "+errorDetail(err, s.debug),
+		)
+		return types.StringNull(), diags
+	}
+	return types.StringValue(syncResp.CommandId), diags
+}
+
+// wait waits for the sync command of the planned application to be handled successfully when wait is set.
+func (s *ApplicationSyncResource) wait(ctx context.Context, plan applicationSyncResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !plan.Wait.ValueBool() {
+		return diags
+	}
+
+	appID := plan.ApplicationID.ValueString()
+	commandID := plan.CommandID.ValueString()
+
+	timeoutValue := defaultApplicationSyncWaitTimeout
+	if !plan.WaitTimeout.IsNull() {
+		timeoutValue = plan.WaitTimeout.ValueString()
+	}
+	timeout, _ := time.ParseDuration(timeoutValue)
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cmd, err := waitForCommand(waitCtx, s.c, commandID, commandPollInterval)
+	if err != nil {
+		diags.AddError(
+			"Error waiting for application sync",
+			"The sync of application "+appID+" was triggered but its command "+commandID+
+				" was not handled within "+timeout.String()+": "+errorDetail(err, s.debug),
+		)
+		return diags
+	}
+	if cmd.Status != model.CommandStatus_COMMAND_SUCCEEDED {
+		diags.AddError(
+			"Application sync failed",
+			fmt.Sprintf("The sync command %s of application %s was not handled successfully: %s", commandID, appID, cmd.Status),
+		)
+	}
+	return diags
+}
+
+// waitForCommand polls the given command every interval until it is handled by its piped.
+func waitForCommand(ctx context.Context, c APIClient, commandID string, interval time.Duration) (*model.Command, error) {
+	var cmd *model.Command
+	err := poll(ctx, interval, func(ctx context.Context) (bool, error) {
+		getResp, err := c.GetCommand(ctx, &api.GetCommandRequest{CommandId: commandID})
+		if err != nil {
+			return false, err
+		}
+		cmd = getResp.Command
+		return cmd.Status != model.CommandStatus_COMMAND_NOT_HANDLED_YET, nil
+	})
+	return cmd, err
+}
@@ -0,0 +1,212 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/model"
+	"github.com/pipe-cd/terraform-provider-pipecd/internal/provider/mock"
+)
+
+func TestAccResourceApplicationSync(t *testing.T) {
+	t.Parallel()
+
+	syncReq := &apiservice.SyncApplicationRequest{ApplicationId: "test_application_id"}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	gomock.InOrder(
+		client.EXPECT().SyncApplication(gomock.Any(), protoEq(syncReq)).Return(&apiservice.SyncApplicationResponse{CommandId: "command-1"}, nil),
+		client.EXPECT().SyncApplication(gomock.Any(), protoEq(syncReq)).Return(&apiservice.SyncApplicationResponse{CommandId: "command-2"}, nil),
+	)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplicationSync("v1", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pipecd_application_sync.test", "application_id", "test_application_id"),
+					resource.TestCheckResourceAttr("pipecd_application_sync.test", "triggers.revision", "v1"),
+					resource.TestCheckResourceAttr("pipecd_application_sync.test", "command_id", "command-1"),
+				),
+			},
+			{
+				// Changing the triggers syncs the application again.
+				Config: testAccResourceApplicationSync("v2", ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pipecd_application_sync.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue("pipecd_application_sync.test", tfjsonpath.New("command_id")),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("pipecd_application_sync.test", "command_id", "command-2"),
+			},
+			{
+				// Changing anything else does not.
+				Config: testAccResourceApplicationSync("v2", `wait_timeout = "1m"`),
+				Check:  resource.TestCheckResourceAttr("pipecd_application_sync.test", "command_id", "command-2"),
+			},
+		},
+	})
+}
+
+func TestAccResourceApplicationSyncWait(t *testing.T) {
+	t.Parallel()
+
+	syncReq := &apiservice.SyncApplicationRequest{ApplicationId: "test_application_id"}
+	getReq := &apiservice.GetCommandRequest{CommandId: "command-1"}
+	getResp := &apiservice.GetCommandResponse{
+		Command: &model.Command{Id: "command-1", Status: model.CommandStatus_COMMAND_SUCCEEDED},
+	}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	client.EXPECT().SyncApplication(gomock.Any(), protoEq(syncReq)).Return(&apiservice.SyncApplicationResponse{CommandId: "command-1"}, nil)
+	client.EXPECT().GetCommand(gomock.Any(), protoEq(getReq)).Return(getResp, nil)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplicationSync("v1", "wait = true"),
+				Check:  resource.TestCheckResourceAttr("pipecd_application_sync.test", "command_id", "command-1"),
+			},
+		},
+	})
+}
+
+func TestAccResourceApplicationSyncWaitFailed(t *testing.T) {
+	t.Parallel()
+
+	syncReq := &apiservice.SyncApplicationRequest{ApplicationId: "test_application_id"}
+	getReq := &apiservice.GetCommandRequest{CommandId: "command-1"}
+	getResp := &apiservice.GetCommandResponse{
+		Command: &model.Command{Id: "command-1", Status: model.CommandStatus_COMMAND_FAILED},
+	}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	client.EXPECT().SyncApplication(gomock.Any(), protoEq(syncReq)).Return(&apiservice.SyncApplicationResponse{CommandId: "command-1"}, nil)
+	client.EXPECT().GetCommand(gomock.Any(), protoEq(getReq)).Return(getResp, nil)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceApplicationSync("v1", "wait = true"),
+				ExpectError: regexp.MustCompile("COMMAND_FAILED"),
+			},
+			{
+				// The triggered sync was recorded as tainted, so the next apply syncs the application again.
+				Config:             testAccResourceApplicationSync("v1", "wait = true"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pipecd_application_sync.test", plancheck.ResourceActionReplace),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceApplicationSyncUpdateWaitFailed(t *testing.T) {
+	t.Parallel()
+
+	syncReq := &apiservice.SyncApplicationRequest{ApplicationId: "test_application_id"}
+	getReq := &apiservice.GetCommandRequest{CommandId: "command-2"}
+	getResp := &apiservice.GetCommandResponse{
+		Command: &model.Command{Id: "command-2", Status: model.CommandStatus_COMMAND_FAILED},
+	}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	gomock.InOrder(
+		client.EXPECT().SyncApplication(gomock.Any(), protoEq(syncReq)).Return(&apiservice.SyncApplicationResponse{CommandId: "command-1"}, nil),
+		client.EXPECT().SyncApplication(gomock.Any(), protoEq(syncReq)).Return(&apiservice.SyncApplicationResponse{CommandId: "command-2"}, nil),
+	)
+	client.EXPECT().GetCommand(gomock.Any(), protoEq(getReq)).Return(getResp, nil)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplicationSync("v1", ""),
+			},
+			{
+				Config:      testAccResourceApplicationSync("v2", "wait = true"),
+				ExpectError: regexp.MustCompile("COMMAND_FAILED"),
+			},
+			{
+				// The command of the triggered sync was recorded, so the next apply does not sync the application again.
+				Config:   testAccResourceApplicationSync("v2", "wait = true"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestWaitForCommand(t *testing.T) {
+	t.Parallel()
+
+	getReq := &apiservice.GetCommandRequest{CommandId: "command_id"}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	gomock.InOrder(
+		client.EXPECT().GetCommand(gomock.Any(), protoEq(getReq)).Return(&apiservice.GetCommandResponse{
+			Command: &model.Command{Id: "command_id", Status: model.CommandStatus_COMMAND_NOT_HANDLED_YET},
+		}, nil),
+		client.EXPECT().GetCommand(gomock.Any(), protoEq(getReq)).Return(&apiservice.GetCommandResponse{
+			Command: &model.Command{Id: "command_id", Status: model.CommandStatus_COMMAND_SUCCEEDED},
+		}, nil),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	cmd, err := waitForCommand(ctx, client, "command_id", 10*time.Millisecond)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if cmd.Status != model.CommandStatus_COMMAND_SUCCEEDED {
+		t.Errorf("unexpected command status: %s", cmd.Status)
+	}
+}
+
+func testAccResourceApplicationSync(revision, extra string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "pipecd_application_sync" "test" {
+	application_id = "test_application_id"
+	triggers = {
+		revision = "%s"
+	}
+	%s
+}`, revision, extra)
+}
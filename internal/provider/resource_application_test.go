@@ -15,10 +15,27 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
 	"github.com/pipe-cd/pipecd/pkg/model"
@@ -58,8 +75,12 @@ func TestAccResourceApplication(t *testing.T) {
 	}
 	addResp := &apiservice.AddApplicationResponse{ApplicationId: appID}
 
+	registered := proto.Clone(app).(*model.Application)
+	registered.GitPath.Repo.Remote = "git@github.com:pipe-cd/examples.git"
+	registered.GitPath.Repo.Branch = "main"
+
 	getReq := &apiservice.GetApplicationRequest{ApplicationId: appID}
-	getResp := &apiservice.GetApplicationResponse{Application: app}
+	getResp := &apiservice.GetApplicationResponse{Application: registered}
 
 	updateReq := &apiservice.UpdateApplicationRequest{ApplicationId: appID}
 	updateResp := &apiservice.UpdateApplicationResponse{ApplicationId: appID}
@@ -69,10 +90,11 @@ func TestAccResourceApplication(t *testing.T) {
 
 	ctrl := gomock.NewController(t)
 	client := mock.NewMockAPIClient(ctrl)
-	client.EXPECT().AddApplication(gomock.Any(), addReq).Return(addResp, nil).AnyTimes()
-	client.EXPECT().GetApplication(gomock.Any(), getReq).Return(getResp, nil).AnyTimes()
-	client.EXPECT().UpdateApplication(gomock.Any(), updateReq).Return(updateResp, nil).AnyTimes()
-	client.EXPECT().DeleteApplication(gomock.Any(), deleteReq).Return(deleteResp, nil).AnyTimes()
+	expectEnabledPiped(client, "test_piped_id")
+	client.EXPECT().AddApplication(gomock.Any(), protoEq(addReq)).Return(addResp, nil).AnyTimes()
+	client.EXPECT().GetApplication(gomock.Any(), protoEq(getReq)).Return(getResp, nil).AnyTimes()
+	client.EXPECT().UpdateApplication(gomock.Any(), protoEq(updateReq)).Return(updateResp, nil).AnyTimes()
+	client.EXPECT().DeleteApplication(gomock.Any(), protoEq(deleteReq)).Return(deleteResp, nil).AnyTimes()
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
@@ -85,6 +107,8 @@ func TestAccResourceApplication(t *testing.T) {
 					resource.TestCheckResourceAttr("pipecd_application.test", "platform_provider", "test_provider"),
 					resource.TestCheckResourceAttr("pipecd_application.test", "description", "test description"),
 					resource.TestCheckResourceAttr("pipecd_application.test", "git.repository_id", "repo_id"),
+					resource.TestCheckResourceAttr("pipecd_application.test", "git.remote", "git@github.com:pipe-cd/examples.git"),
+					resource.TestCheckResourceAttr("pipecd_application.test", "git.branch", "main"),
 					resource.TestCheckResourceAttr("pipecd_application.test", "git.path", "path/to/config"),
 					resource.TestCheckResourceAttr("pipecd_application.test", "git.filename", "testapp.pipecd.yaml"),
 				),
@@ -93,8 +117,302 @@ func TestAccResourceApplication(t *testing.T) {
 	})
 }
 
-func testAccResourceApplication() string {
-	return providerConfig + `
+func TestAccResourceApplicationGetAfterAddFails(t *testing.T) {
+	t.Parallel()
+
+	const appID = "test_application_id"
+
+	addReq := &apiservice.AddApplicationRequest{
+		Name:    "test_application",
+		PipedId: "test_piped_id",
+		GitPath: &model.ApplicationGitPath{
+			Repo: &model.ApplicationGitRepository{
+				Id: "repo_id",
+			},
+			Path:           "path/to/config",
+			ConfigFilename: "testapp.pipecd.yaml",
+		},
+		Kind:             model.ApplicationKind_CLOUDRUN,
+		PlatformProvider: "test_provider",
+		Description:      "test description",
+	}
+	addResp := &apiservice.AddApplicationResponse{ApplicationId: appID}
+
+	getReq := &apiservice.GetApplicationRequest{ApplicationId: appID}
+	getResp := &apiservice.GetApplicationResponse{
+		Application: &model.Application{
+			Id:               appID,
+			Name:             addReq.Name,
+			PipedId:          addReq.PipedId,
+			GitPath:          addReq.GitPath,
+			Kind:             addReq.Kind,
+			PlatformProvider: addReq.PlatformProvider,
+			Description:      addReq.Description,
+		},
+	}
+
+	deleteReq := &apiservice.DeleteApplicationRequest{ApplicationId: appID}
+	deleteResp := &apiservice.DeleteApplicationResponse{ApplicationId: appID}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	expectEnabledPiped(client, "test_piped_id")
+	client.EXPECT().AddApplication(gomock.Any(), protoEq(addReq)).Return(addResp, nil).Times(1)
+	gomock.InOrder(
+		client.EXPECT().GetApplication(gomock.Any(), protoEq(getReq)).Return(nil, status.Error(codes.DeadlineExceeded, "timeout")).Times(1),
+		client.EXPECT().GetApplication(gomock.Any(), protoEq(getReq)).Return(getResp, nil).AnyTimes(),
+	)
+	// The created application is deleted on destroy, which is only possible if its ID was persisted.
+	client.EXPECT().DeleteApplication(gomock.Any(), protoEq(deleteReq)).Return(deleteResp, nil).Times(1)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceApplication(),
+				ExpectError: regexp.MustCompile("was created, but could not be read back"),
+			},
+			{
+				Config:             testAccResourceApplication(),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pipecd_application.test", plancheck.ResourceActionReplace),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceApplicationLabels(t *testing.T) {
+	t.Parallel()
+
+	const appID = "test_application_id"
+
+	// app is the application stored in the control plane.
+	var mu sync.Mutex
+	app := &model.Application{
+		Id:      appID,
+		Name:    "test_application",
+		PipedId: "test_piped_id",
+		GitPath: &model.ApplicationGitPath{
+			Repo: &model.ApplicationGitRepository{
+				Id: "repo_id",
+			},
+			Path:           "path/to/config",
+			ConfigFilename: "testapp.pipecd.yaml",
+		},
+		Kind:             model.ApplicationKind_CLOUDRUN,
+		PlatformProvider: "test_provider",
+		Description:      "test description",
+	}
+	// setLabels simulates the labels being changed in the application configuration in Git.
+	setLabels := func(labels map[string]string) {
+		mu.Lock()
+		defer mu.Unlock()
+		app = proto.Clone(app).(*model.Application)
+		app.Labels = labels
+	}
+
+	addReq := &apiservice.AddApplicationRequest{
+		Name:             app.Name,
+		PipedId:          app.PipedId,
+		GitPath:          app.GitPath,
+		Kind:             app.Kind,
+		PlatformProvider: app.PlatformProvider,
+		Description:      app.Description,
+	}
+
+	getReq := &apiservice.GetApplicationRequest{ApplicationId: appID}
+
+	deleteReq := &apiservice.DeleteApplicationRequest{ApplicationId: appID}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	expectEnabledPiped(client, "test_piped_id")
+	client.EXPECT().AddApplication(gomock.Any(), protoEq(addReq)).Return(&apiservice.AddApplicationResponse{ApplicationId: appID}, nil).Times(1)
+	client.EXPECT().GetApplication(gomock.Any(), protoEq(getReq)).DoAndReturn(
+		func(_ context.Context, _ *apiservice.GetApplicationRequest, _ ...grpc.CallOption) (*apiservice.GetApplicationResponse, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			return &apiservice.GetApplicationResponse{Application: proto.Clone(app).(*model.Application)}, nil
+		},
+	).AnyTimes()
+	client.EXPECT().DeleteApplication(gomock.Any(), protoEq(deleteReq)).Return(&apiservice.DeleteApplicationResponse{}, nil).Times(1)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplication(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pipecd_application.test", "labels.%", "0"),
+				),
+			},
+			{
+				// Labels added.
+				PreConfig: func() {
+					setLabels(map[string]string{"team": "payments"})
+				},
+				Config: testAccResourceApplication(),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pipecd_application.test", "labels.%", "1"),
+					resource.TestCheckResourceAttr("pipecd_application.test", "labels.team", "payments"),
+				),
+			},
+			{
+				// Labels changed.
+				PreConfig: func() {
+					setLabels(map[string]string{"team": "billing", "env": "prod"})
+				},
+				Config: testAccResourceApplication(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pipecd_application.test", "labels.%", "2"),
+					resource.TestCheckResourceAttr("pipecd_application.test", "labels.team", "billing"),
+					resource.TestCheckResourceAttr("pipecd_application.test", "labels.env", "prod"),
+				),
+			},
+			{
+				// Labels cleared.
+				PreConfig: func() {
+					setLabels(nil)
+				},
+				Config: testAccResourceApplication(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pipecd_application.test", "labels.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceApplicationEnabled(t *testing.T) {
+	t.Parallel()
+
+	const appID = "test_application_id"
+
+	// app is the application stored in the control plane.
+	var mu sync.Mutex
+	app := &model.Application{
+		Id:      appID,
+		Name:    "test_application",
+		PipedId: "test_piped_id",
+		GitPath: &model.ApplicationGitPath{
+			Repo: &model.ApplicationGitRepository{
+				Id: "repo_id",
+			},
+			Path:           "path/to/config",
+			ConfigFilename: "testapp.pipecd.yaml",
+		},
+		Kind:             model.ApplicationKind_CLOUDRUN,
+		PlatformProvider: "test_provider",
+		Description:      "test description",
+	}
+	setDisabled := func(disabled bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		app = proto.Clone(app).(*model.Application)
+		app.Disabled = disabled
+	}
+
+	addReq := &apiservice.AddApplicationRequest{
+		Name:             app.Name,
+		PipedId:          app.PipedId,
+		GitPath:          app.GitPath,
+		Kind:             app.Kind,
+		PlatformProvider: app.PlatformProvider,
+		Description:      app.Description,
+	}
+
+	updateReq := &apiservice.UpdateApplicationRequest{
+		ApplicationId:    appID,
+		PipedId:          app.PipedId,
+		PlatformProvider: app.PlatformProvider,
+		GitPath:          app.GitPath,
+	}
+
+	getReq := &apiservice.GetApplicationRequest{ApplicationId: appID}
+
+	enableReq := &apiservice.EnableApplicationRequest{ApplicationId: appID}
+
+	disableReq := &apiservice.DisableApplicationRequest{ApplicationId: appID}
+
+	deleteReq := &apiservice.DeleteApplicationRequest{ApplicationId: appID}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	expectEnabledPiped(client, "test_piped_id")
+	client.EXPECT().AddApplication(gomock.Any(), protoEq(addReq)).Return(&apiservice.AddApplicationResponse{ApplicationId: appID}, nil).Times(1)
+	client.EXPECT().UpdateApplication(gomock.Any(), protoEq(updateReq)).Return(&apiservice.UpdateApplicationResponse{}, nil).Times(2)
+	client.EXPECT().GetApplication(gomock.Any(), protoEq(getReq)).DoAndReturn(
+		func(_ context.Context, _ *apiservice.GetApplicationRequest, _ ...grpc.CallOption) (*apiservice.GetApplicationResponse, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			return &apiservice.GetApplicationResponse{Application: proto.Clone(app).(*model.Application)}, nil
+		},
+	).AnyTimes()
+	client.EXPECT().EnableApplication(gomock.Any(), protoEq(enableReq)).DoAndReturn(
+		func(_ context.Context, _ *apiservice.EnableApplicationRequest, _ ...grpc.CallOption) (*apiservice.EnableApplicationResponse, error) {
+			setDisabled(false)
+			return &apiservice.EnableApplicationResponse{}, nil
+		},
+	).Times(1)
+	client.EXPECT().DisableApplication(gomock.Any(), protoEq(disableReq)).DoAndReturn(
+		func(_ context.Context, _ *apiservice.DisableApplicationRequest, _ ...grpc.CallOption) (*apiservice.DisableApplicationResponse, error) {
+			setDisabled(true)
+			return &apiservice.DisableApplicationResponse{}, nil
+		},
+	).Times(2)
+	client.EXPECT().DeleteApplication(gomock.Any(), protoEq(deleteReq)).Return(&apiservice.DeleteApplicationResponse{}, nil).Times(1)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				// Created disabled.
+				Config: testAccResourceApplicationEnabled(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pipecd_application.test", "enabled", "false"),
+				),
+			},
+			{
+				Config: testAccResourceApplicationEnabled(true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pipecd_application.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pipecd_application.test", "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccResourceApplicationEnabled(false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pipecd_application.test", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceApplicationEnabled(enabled bool) string {
+	return providerConfig + fmt.Sprintf(`
 resource "pipecd_application" "test" {
 	name = "test_application"
 	piped_id = "test_piped_id"
@@ -106,5 +424,1392 @@ resource "pipecd_application" "test" {
 		path = "path/to/config"
 		filename = "testapp.pipecd.yaml"
 	}
-}`
+	enabled = %t
+}`, enabled)
+}
+
+func TestAccResourceApplicationImportDisabled(t *testing.T) {
+	t.Parallel()
+
+	const appID = "5f0c3a2b-9d8e-4c7b-a6f5-1e2d3c4b5a69"
+
+	// app is the application stored in the control plane, which was disabled outside of Terraform.
+	var mu sync.Mutex
+	app := &model.Application{
+		Id:      appID,
+		Name:    "test_application",
+		PipedId: "test_piped_id",
+		GitPath: &model.ApplicationGitPath{
+			Repo: &model.ApplicationGitRepository{
+				Id: "repo_id",
+			},
+			Path:           "path/to/config",
+			ConfigFilename: "testapp.pipecd.yaml",
+		},
+		Kind:             model.ApplicationKind_CLOUDRUN,
+		PlatformProvider: "test_provider",
+		Description:      "test description",
+		Disabled:         true,
+	}
+
+	updateReq := &apiservice.UpdateApplicationRequest{
+		ApplicationId:    appID,
+		PipedId:          app.PipedId,
+		PlatformProvider: app.PlatformProvider,
+		GitPath:          app.GitPath,
+	}
+
+	getReq := &apiservice.GetApplicationRequest{ApplicationId: appID}
+
+	enableReq := &apiservice.EnableApplicationRequest{ApplicationId: appID}
+
+	deleteReq := &apiservice.DeleteApplicationRequest{ApplicationId: appID}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	client.EXPECT().GetApplication(gomock.Any(), protoEq(getReq)).DoAndReturn(
+		func(_ context.Context, _ *apiservice.GetApplicationRequest, _ ...grpc.CallOption) (*apiservice.GetApplicationResponse, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			return &apiservice.GetApplicationResponse{Application: proto.Clone(app).(*model.Application)}, nil
+		},
+	).AnyTimes()
+	client.EXPECT().UpdateApplication(gomock.Any(), protoEq(updateReq)).Return(&apiservice.UpdateApplicationResponse{}, nil).Times(1)
+	client.EXPECT().EnableApplication(gomock.Any(), protoEq(enableReq)).DoAndReturn(
+		func(_ context.Context, _ *apiservice.EnableApplicationRequest, _ ...grpc.CallOption) (*apiservice.EnableApplicationResponse, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			app = proto.Clone(app).(*model.Application)
+			app.Disabled = false
+			return &apiservice.EnableApplicationResponse{}, nil
+		},
+	).Times(1)
+	client.EXPECT().DeleteApplication(gomock.Any(), protoEq(deleteReq)).Return(&apiservice.DeleteApplicationResponse{}, nil).Times(1)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config:             testAccResourceApplicationEnabled(true),
+				ResourceName:       "pipecd_application.test",
+				ImportState:        true,
+				ImportStateId:      appID,
+				ImportStatePersist: true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 || states[0].Attributes["enabled"] != "false" {
+						return fmt.Errorf("expected the imported application to be disabled, got: %v", states)
+					}
+					return nil
+				},
+			},
+			{
+				Config: testAccResourceApplicationEnabled(true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pipecd_application.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pipecd_application.test", "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceApplicationEnabledWithoutDescription(t *testing.T) {
+	t.Parallel()
+
+	const appID = "test_application_id"
+
+	var mu sync.Mutex
+	app := &model.Application{
+		Id:      appID,
+		Name:    "test_application",
+		PipedId: "test_piped_id",
+		GitPath: &model.ApplicationGitPath{
+			Repo: &model.ApplicationGitRepository{
+				Id: "repo_id",
+			},
+			Path:           "path/to/config",
+			ConfigFilename: "testapp.pipecd.yaml",
+		},
+		Kind:             model.ApplicationKind_CLOUDRUN,
+		PlatformProvider: "test_provider",
+	}
+
+	addReq := &apiservice.AddApplicationRequest{
+		Name:             app.Name,
+		PipedId:          app.PipedId,
+		GitPath:          app.GitPath,
+		Kind:             app.Kind,
+		PlatformProvider: app.PlatformProvider,
+	}
+
+	updateReq := &apiservice.UpdateApplicationRequest{
+		ApplicationId:    appID,
+		PipedId:          app.PipedId,
+		PlatformProvider: app.PlatformProvider,
+		GitPath:          app.GitPath,
+	}
+
+	getReq := &apiservice.GetApplicationRequest{ApplicationId: appID}
+
+	disableReq := &apiservice.DisableApplicationRequest{ApplicationId: appID}
+
+	deleteReq := &apiservice.DeleteApplicationRequest{ApplicationId: appID}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	expectEnabledPiped(client, "test_piped_id")
+	// The application is created once and then updated in place, never replaced.
+	client.EXPECT().AddApplication(gomock.Any(), protoEq(addReq)).Return(&apiservice.AddApplicationResponse{ApplicationId: appID}, nil).Times(1)
+	client.EXPECT().UpdateApplication(gomock.Any(), protoEq(updateReq)).Return(&apiservice.UpdateApplicationResponse{}, nil).Times(1)
+	client.EXPECT().GetApplication(gomock.Any(), protoEq(getReq)).DoAndReturn(
+		func(_ context.Context, _ *apiservice.GetApplicationRequest, _ ...grpc.CallOption) (*apiservice.GetApplicationResponse, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			return &apiservice.GetApplicationResponse{Application: proto.Clone(app).(*model.Application)}, nil
+		},
+	).AnyTimes()
+	client.EXPECT().DisableApplication(gomock.Any(), protoEq(disableReq)).DoAndReturn(
+		func(_ context.Context, _ *apiservice.DisableApplicationRequest, _ ...grpc.CallOption) (*apiservice.DisableApplicationResponse, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			app = proto.Clone(app).(*model.Application)
+			app.Disabled = true
+			return &apiservice.DisableApplicationResponse{}, nil
+		},
+	).Times(1)
+	client.EXPECT().DeleteApplication(gomock.Any(), protoEq(deleteReq)).Return(&apiservice.DeleteApplicationResponse{}, nil).Times(1)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplicationEnabledWithoutDescription(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pipecd_application.test", "description", ""),
+				),
+			},
+			{
+				Config: testAccResourceApplicationEnabledWithoutDescription(false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pipecd_application.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pipecd_application.test", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceApplicationEnabledWithoutDescription(enabled bool) string {
+	return providerConfig + fmt.Sprintf(`
+resource "pipecd_application" "test" {
+	name = "test_application"
+	piped_id = "test_piped_id"
+	kind = "CLOUDRUN"
+	platform_provider = "test_provider"
+	git = {
+		repository_id = "repo_id"
+		path = "path/to/config"
+		filename = "testapp.pipecd.yaml"
+	}
+	enabled = %t
+}`, enabled)
+}
+
+// expectEnabledPiped lets the given piped be found enabled when an application is created.
+func expectEnabledPiped(client *mock.MockAPIClient, pipedID string) {
+	client.EXPECT().GetPiped(gomock.Any(), protoEq(&apiservice.GetPipedRequest{PipedId: pipedID})).
+		Return(&apiservice.GetPipedResponse{Piped: &model.Piped{Id: pipedID, Name: "test_piped"}}, nil).AnyTimes()
+}
+
+func TestAccResourceApplicationDisabledPiped(t *testing.T) {
+	t.Parallel()
+
+	getPipedReq := &apiservice.GetPipedRequest{PipedId: "test_piped_id"}
+	getPipedResp := &apiservice.GetPipedResponse{
+		Piped: &model.Piped{Id: "test_piped_id", Name: "test_piped", Disabled: true},
+	}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	client.EXPECT().GetPiped(gomock.Any(), protoEq(getPipedReq)).Return(getPipedResp, nil).Times(1)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceApplication(),
+				ExpectError: regexp.MustCompile(`(?s)Piped is disabled.*"test_piped" \(test_piped_id\) is disabled`),
+			},
+		},
+	})
+}
+
+func testAccResourceApplication() string {
+	return providerConfig + `
+resource "pipecd_application" "test" {
+	name = "test_application"
+	piped_id = "test_piped_id"
+	kind = "CLOUDRUN"
+	platform_provider = "test_provider"
+	description = "test description"
+	git = {
+		repository_id = "repo_id"
+		path = "path/to/config"
+		filename = "testapp.pipecd.yaml"
+	}
+}`
+}
+
+func TestCheckPipedOnline(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		status      model.Piped_ConnectionStatus
+		strict      bool
+		wantWarning bool
+		wantError   bool
+	}{
+		{
+			name:   "online piped",
+			status: model.Piped_ONLINE,
+		},
+		{
+			name:        "offline piped",
+			status:      model.Piped_OFFLINE,
+			wantWarning: true,
+		},
+		{
+			name:      "offline piped in strict mode",
+			status:    model.Piped_OFFLINE,
+			strict:    true,
+			wantError: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			client := mock.NewMockAPIClient(ctrl)
+			client.EXPECT().GetPiped(gomock.Any(), protoEq(&apiservice.GetPipedRequest{PipedId: "test_piped_id"})).Return(&apiservice.GetPipedResponse{
+				Piped: &model.Piped{Id: "test_piped_id", Name: "test_piped", Status: tc.status},
+			}, nil)
+
+			diags := checkPipedOnline(context.Background(), client, "test_piped_id", tc.strict, false)
+			if got := diags.WarningsCount() > 0; got != tc.wantWarning {
+				t.Errorf("unexpected warnings: %v", diags)
+			}
+			if got := diags.HasError(); got != tc.wantError {
+				t.Errorf("unexpected errors: %v", diags)
+			}
+		})
+	}
+}
+
+func TestCheckPlatformProvider(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name      string
+		provider  string
+		wantError string
+	}{
+		{
+			name:     "known type",
+			provider: "kubernetes-default",
+		},
+		{
+			name:      "unknown type",
+			provider:  "kubernetes-typo",
+			wantError: "Unknown platform provider type",
+		},
+		{
+			name:      "unregistered provider",
+			provider:  "not-registered",
+			wantError: "Unknown platform provider",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			client := mock.NewMockAPIClient(ctrl)
+			client.EXPECT().GetPiped(gomock.Any(), protoEq(&apiservice.GetPipedRequest{PipedId: "test_piped_id"})).Return(&apiservice.GetPipedResponse{
+				Piped: &model.Piped{
+					Id:   "test_piped_id",
+					Name: "test_piped",
+					PlatformProviders: []*model.Piped_PlatformProvider{
+						{Name: "kubernetes-default", Type: "KUBERNETES"},
+						{Name: "kubernetes-typo", Type: "KUBERNETESS"},
+					},
+				},
+			}, nil)
+
+			diags := checkPlatformProvider(context.Background(), client, "test_piped_id", tc.provider, false)
+			if tc.wantError == "" {
+				if diags.HasError() {
+					t.Errorf("unexpected errors: %v", diags)
+				}
+				return
+			}
+			if diags.ErrorsCount() != 1 {
+				t.Errorf("expected an error, got %v", diags)
+				return
+			}
+			if got := diags.Errors()[0].Summary(); got != tc.wantError {
+				t.Errorf("expected error %q, got %q", tc.wantError, got)
+			}
+			if tc.wantError == "Unknown platform provider type" && !strings.Contains(diags.Errors()[0].Detail(), "KUBERNETES, TERRAFORM, LAMBDA, CLOUDRUN, ECS") {
+				t.Errorf("error should list known types, got %q", diags.Errors()[0].Detail())
+			}
+		})
+	}
+}
+
+func TestAccResourceApplicationHeredocDescription(t *testing.T) {
+	t.Parallel()
+
+	const appID = "test_application_id"
+
+	app := &model.Application{
+		Id:      appID,
+		Name:    "test_application",
+		PipedId: "test_piped_id",
+		GitPath: &model.ApplicationGitPath{
+			Repo: &model.ApplicationGitRepository{
+				Id: "repo_id",
+			},
+			Path:           "path/to/config",
+			ConfigFilename: "app.pipecd.yaml",
+		},
+		Kind:             model.ApplicationKind_KUBERNETES,
+		PlatformProvider: "test_provider",
+		Description:      "test description",
+	}
+
+	addReq := &apiservice.AddApplicationRequest{
+		Name:             app.Name,
+		PipedId:          app.PipedId,
+		GitPath:          app.GitPath,
+		Kind:             app.Kind,
+		PlatformProvider: app.PlatformProvider,
+		Description:      app.Description,
+	}
+	addResp := &apiservice.AddApplicationResponse{ApplicationId: appID}
+
+	getReq := &apiservice.GetApplicationRequest{ApplicationId: appID}
+	getResp := &apiservice.GetApplicationResponse{Application: app}
+
+	deleteReq := &apiservice.DeleteApplicationRequest{ApplicationId: appID}
+	deleteResp := &apiservice.DeleteApplicationResponse{ApplicationId: appID}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	expectEnabledPiped(client, "test_piped_id")
+	client.EXPECT().AddApplication(gomock.Any(), protoEq(addReq)).Return(addResp, nil).AnyTimes()
+	client.EXPECT().GetApplication(gomock.Any(), protoEq(getReq)).Return(getResp, nil).AnyTimes()
+	client.EXPECT().DeleteApplication(gomock.Any(), protoEq(deleteReq)).Return(deleteResp, nil).AnyTimes()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplicationHeredocDescription(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pipecd_application.test", "description", "test description\n"),
+				),
+			},
+			{
+				Config:   testAccResourceApplicationHeredocDescription(),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceApplicationEmptyFilename(t *testing.T) {
+	t.Parallel()
+
+	const appID = "test_application_id"
+
+	addReq := &apiservice.AddApplicationRequest{
+		Name:    "test_application",
+		PipedId: "test_piped_id",
+		GitPath: &model.ApplicationGitPath{
+			Repo: &model.ApplicationGitRepository{
+				Id: "repo_id",
+			},
+			Path:           "path/to/config",
+			ConfigFilename: "app.pipecd.yaml",
+		},
+		Kind:             model.ApplicationKind_KUBERNETES,
+		PlatformProvider: "test_provider",
+	}
+	addResp := &apiservice.AddApplicationResponse{ApplicationId: appID}
+
+	// PipeCD returns the application without its config file name.
+	getReq := &apiservice.GetApplicationRequest{ApplicationId: appID}
+	getResp := &apiservice.GetApplicationResponse{
+		Application: &model.Application{
+			Id:      appID,
+			Name:    "test_application",
+			PipedId: "test_piped_id",
+			GitPath: &model.ApplicationGitPath{
+				Repo: &model.ApplicationGitRepository{
+					Id: "repo_id",
+				},
+				Path: "path/to/config",
+			},
+			Kind:             model.ApplicationKind_KUBERNETES,
+			PlatformProvider: "test_provider",
+		},
+	}
+
+	deleteReq := &apiservice.DeleteApplicationRequest{ApplicationId: appID}
+	deleteResp := &apiservice.DeleteApplicationResponse{ApplicationId: appID}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	expectEnabledPiped(client, "test_piped_id")
+	client.EXPECT().AddApplication(gomock.Any(), protoEq(addReq)).Return(addResp, nil).AnyTimes()
+	client.EXPECT().GetApplication(gomock.Any(), protoEq(getReq)).Return(getResp, nil).AnyTimes()
+	client.EXPECT().DeleteApplication(gomock.Any(), protoEq(deleteReq)).Return(deleteResp, nil).AnyTimes()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplicationEmptyFilename(),
+				Check:  resource.TestCheckResourceAttr("pipecd_application.test", "git.filename", "app.pipecd.yaml"),
+			},
+			{
+				Config:   testAccResourceApplicationEmptyFilename(),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccResourceApplicationEmptyFilename() string {
+	return providerConfig + `
+resource "pipecd_application" "test" {
+	name = "test_application"
+	piped_id = "test_piped_id"
+	kind = "KUBERNETES"
+	platform_provider = "test_provider"
+	git = {
+		repository_id = "repo_id"
+		path = "path/to/config"
+	}
+}`
+}
+
+func testAccResourceApplicationHeredocDescription() string {
+	return providerConfig + `
+resource "pipecd_application" "test" {
+	name = "test_application"
+	piped_id = "test_piped_id"
+	kind = "KUBERNETES"
+	platform_provider = "test_provider"
+	description = <<-EOT
+		test description
+	EOT
+	git = {
+		repository_id = "repo_id"
+		path = "path/to/config"
+	}
+}`
+}
+
+func TestApplicationResourceGit(t *testing.T) {
+	t.Parallel()
+
+	configured := &applicationResourceGitModel{
+		RepositoryID: types.StringValue("repo_id"),
+		Remote:       types.StringUnknown(),
+		Branch:       types.StringUnknown(),
+		Path:         types.StringValue("path/to/config"),
+		Filename:     types.StringValue("app.pipecd.yaml"),
+	}
+
+	got := applicationResourceGit(nil, configured)
+	expected := &applicationResourceGitModel{
+		RepositoryID: types.StringValue("repo_id"),
+		Remote:       types.StringNull(),
+		Branch:       types.StringNull(),
+		Path:         types.StringValue("path/to/config"),
+		Filename:     types.StringValue("app.pipecd.yaml"),
+	}
+	if *got != *expected {
+		t.Errorf("expected the configured git to be kept, got %+v", got)
+	}
+
+	if got := applicationResourceGit(nil, nil); got != nil {
+		t.Errorf("expected nil without a Git path and a fallback, got %+v", got)
+	}
+
+	got = applicationResourceGit(&model.ApplicationGitPath{Path: "path/to/config"}, configured)
+	if got.RepositoryID.ValueString() != "" || got.Path.ValueString() != "path/to/config" {
+		t.Errorf("expected the Git path without its repository to be converted, got %+v", got)
+	}
+	if got.Filename.ValueString() != "app.pipecd.yaml" {
+		t.Errorf("expected an empty file name to be read as the default one, got %q", got.Filename.ValueString())
+	}
+}
+
+func TestCheckPipedOnlineNotFound(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	client.EXPECT().GetPiped(gomock.Any(), protoEq(&apiservice.GetPipedRequest{PipedId: "test_piped_id"})).
+		Return(nil, status.Error(codes.NotFound, "piped not found"))
+
+	diags := checkPipedOnline(context.Background(), client, "test_piped_id", true, false)
+	if diags.HasError() {
+		t.Errorf("expected no error for a deleted piped, got %v", diags)
+	}
+	if diags.WarningsCount() != 1 {
+		t.Errorf("expected a warning for a deleted piped, got %v", diags)
+	}
+}
+
+func TestAccResourceApplicationDefaultPipedID(t *testing.T) {
+	t.Parallel()
+
+	const appID = "test_application_id"
+
+	app := &model.Application{
+		Id:      appID,
+		Name:    "test_application",
+		PipedId: "default_piped_id",
+		GitPath: &model.ApplicationGitPath{
+			Repo: &model.ApplicationGitRepository{
+				Id: "repo_id",
+			},
+			Path:           "path/to/config",
+			ConfigFilename: "app.pipecd.yaml",
+		},
+		Kind:             model.ApplicationKind_KUBERNETES,
+		PlatformProvider: "test_provider",
+	}
+
+	addReq := &apiservice.AddApplicationRequest{
+		Name:             app.Name,
+		PipedId:          app.PipedId,
+		GitPath:          app.GitPath,
+		Kind:             app.Kind,
+		PlatformProvider: app.PlatformProvider,
+	}
+	addResp := &apiservice.AddApplicationResponse{ApplicationId: appID}
+
+	getReq := &apiservice.GetApplicationRequest{ApplicationId: appID}
+	getResp := &apiservice.GetApplicationResponse{Application: app}
+
+	deleteReq := &apiservice.DeleteApplicationRequest{ApplicationId: appID}
+	deleteResp := &apiservice.DeleteApplicationResponse{ApplicationId: appID}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	expectEnabledPiped(client, "default_piped_id")
+	client.EXPECT().AddApplication(gomock.Any(), protoEq(addReq)).Return(addResp, nil).AnyTimes()
+	client.EXPECT().GetApplication(gomock.Any(), protoEq(getReq)).Return(getResp, nil).AnyTimes()
+	client.EXPECT().DeleteApplication(gomock.Any(), protoEq(deleteReq)).Return(deleteResp, nil).AnyTimes()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "pipecd" {
+  host             = "localhost:8018"
+  api_key          = "test"
+  default_piped_id = "default_piped_id"
+}
+` + testAccResourceApplicationWithoutPipedID(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pipecd_application.test", "piped_id", "default_piped_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceApplicationMissingPipedID(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfig + testAccResourceApplicationWithoutPipedID(),
+				ExpectError: regexp.MustCompile("Missing piped_id"),
+			},
+		},
+	})
+}
+
+func TestAccResourceApplicationUnknownPipedID(t *testing.T) {
+	t.Parallel()
+
+	const (
+		appID   = "test_application_id"
+		pipedID = "test_piped_id"
+	)
+
+	registerReq := &apiservice.RegisterPipedRequest{Name: "test_piped"}
+	registerResp := &apiservice.RegisterPipedResponse{Id: pipedID, Key: "test_piped_api_key"}
+
+	getPipedReq := &apiservice.GetPipedRequest{PipedId: pipedID}
+	getPipedResp := &apiservice.GetPipedResponse{Piped: &model.Piped{Id: pipedID, Name: "test_piped"}}
+
+	disablePipedReq := &apiservice.DisablePipedRequest{PipedId: pipedID}
+	disablePipedResp := &apiservice.DisablePipedResponse{}
+
+	app := &model.Application{
+		Id:      appID,
+		Name:    "test_application",
+		PipedId: pipedID,
+		GitPath: &model.ApplicationGitPath{
+			Repo: &model.ApplicationGitRepository{
+				Id: "repo_id",
+			},
+			Path:           "path/to/config",
+			ConfigFilename: "app.pipecd.yaml",
+		},
+		Kind:             model.ApplicationKind_KUBERNETES,
+		PlatformProvider: "test_provider",
+	}
+
+	addReq := &apiservice.AddApplicationRequest{
+		Name:             app.Name,
+		PipedId:          app.PipedId,
+		GitPath:          app.GitPath,
+		Kind:             app.Kind,
+		PlatformProvider: app.PlatformProvider,
+	}
+	addResp := &apiservice.AddApplicationResponse{ApplicationId: appID}
+
+	getReq := &apiservice.GetApplicationRequest{ApplicationId: appID}
+	getResp := &apiservice.GetApplicationResponse{Application: app}
+
+	deleteReq := &apiservice.DeleteApplicationRequest{ApplicationId: appID}
+	deleteResp := &apiservice.DeleteApplicationResponse{ApplicationId: appID}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	client.EXPECT().RegisterPiped(gomock.Any(), protoEq(registerReq)).Return(registerResp, nil).AnyTimes()
+	client.EXPECT().GetPiped(gomock.Any(), protoEq(getPipedReq)).Return(getPipedResp, nil).AnyTimes()
+	client.EXPECT().DisablePiped(gomock.Any(), protoEq(disablePipedReq)).Return(disablePipedResp, nil).AnyTimes()
+	client.EXPECT().AddApplication(gomock.Any(), protoEq(addReq)).Return(addResp, nil).AnyTimes()
+	client.EXPECT().GetApplication(gomock.Any(), protoEq(getReq)).Return(getResp, nil).AnyTimes()
+	client.EXPECT().DeleteApplication(gomock.Any(), protoEq(deleteReq)).Return(deleteResp, nil).AnyTimes()
+
+	config := providerConfig + `
+resource "pipecd_piped" "test" {
+	name = "test_piped"
+}
+
+resource "pipecd_application" "test" {
+	name = "test_application"
+	piped_id = pipecd_piped.test.id
+	kind = "KUBERNETES"
+	platform_provider = "test_provider"
+	git = {
+		repository_id = "repo_id"
+		path = "path/to/config"
+	}
+}`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectUnknownValue("pipecd_application.test", tfjsonpath.New("piped_id")),
+						plancheck.ExpectResourceAction("pipecd_application.test", plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pipecd_application.test", "piped_id", pipedID),
+				),
+			},
+			{
+				// Once the piped ID is known, the application is neither updated nor replaced.
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestApplicationResourceImportStateError(t *testing.T) {
+	t.Parallel()
+
+	const appID = "7d5f0a9e-3c1b-4e8a-9f2d-6b4c8e1a2f30"
+
+	testcases := []struct {
+		name     string
+		err      error
+		calls    int
+		expected string
+	}{
+		{
+			name:     "not found",
+			err:      status.Error(codes.NotFound, "application not found"),
+			calls:    importRetryAttempts,
+			expected: "Application not found",
+		},
+		{
+			name:     "unavailable",
+			err:      status.Error(codes.Unavailable, "connection refused"),
+			calls:    1,
+			expected: "Error reading application",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			ctrl := gomock.NewController(t)
+			client := mock.NewMockAPIClient(ctrl)
+			client.EXPECT().GetApplication(gomock.Any(), protoEq(&apiservice.GetApplicationRequest{ApplicationId: appID})).Return(nil, tc.err).Times(tc.calls)
+
+			r := &ApplicationResource{c: client}
+			var schemaResp fwresource.SchemaResponse
+			r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+			resp := &fwresource.ImportStateResponse{
+				State: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+				},
+			}
+			r.ImportState(ctx, fwresource.ImportStateRequest{ID: appID}, resp)
+
+			if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != tc.expected {
+				t.Errorf("expected %q error, got %v", tc.expected, resp.Diagnostics)
+			}
+			if !resp.State.Raw.IsNull() {
+				t.Errorf("expected no state to be written, got %v", resp.State.Raw)
+			}
+		})
+	}
+}
+
+func TestApplicationResourceImportStateRetry(t *testing.T) {
+	t.Parallel()
+
+	const appID = "7d5f0a9e-3c1b-4e8a-9f2d-6b4c8e1a2f30"
+
+	ctx := context.Background()
+
+	getReq := &apiservice.GetApplicationRequest{ApplicationId: appID}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	gomock.InOrder(
+		client.EXPECT().GetApplication(gomock.Any(), protoEq(getReq)).Return(nil, status.Error(codes.NotFound, "application not found")),
+		client.EXPECT().GetApplication(gomock.Any(), protoEq(getReq)).Return(&apiservice.GetApplicationResponse{
+			Application: &model.Application{
+				Id:      appID,
+				Name:    "test_application",
+				PipedId: "test_piped_id",
+				GitPath: &model.ApplicationGitPath{
+					Repo: &model.ApplicationGitRepository{Id: "repo_id"},
+					Path: "path/to/config",
+				},
+				Kind:             model.ApplicationKind_KUBERNETES,
+				PlatformProvider: "test_provider",
+			},
+		}, nil),
+	)
+
+	r := &ApplicationResource{c: client}
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	resp := &fwresource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	r.ImportState(ctx, fwresource.ImportStateRequest{ID: appID}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("unexpected errors: %v", resp.Diagnostics)
+		return
+	}
+	var state applicationResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if state.ID.ValueString() != appID || state.Name.ValueString() != "test_application" {
+		t.Errorf("unexpected state: %+v", state)
+	}
+}
+
+func TestApplicationResourceImportStateByName(t *testing.T) {
+	t.Parallel()
+
+	const appID = "7d5f0a9e-3c1b-4e8a-9f2d-6b4c8e1a2f30"
+
+	app := &model.Application{
+		Id:        appID,
+		Name:      "test_application",
+		PipedId:   "test_piped_id",
+		ProjectId: "test_project",
+		GitPath: &model.ApplicationGitPath{
+			Repo: &model.ApplicationGitRepository{Id: "repo_id"},
+			Path: "path/to/config",
+		},
+		Kind:             model.ApplicationKind_KUBERNETES,
+		PlatformProvider: "test_provider",
+	}
+	other := proto.Clone(app).(*model.Application)
+	other.Id = "0c6e2b71-8a4d-4f3e-b5c9-1d7a2e9f4b68"
+	other.Disabled = true
+
+	testcases := []struct {
+		name     string
+		importID string
+		enabled  []*model.Application
+		disabled []*model.Application
+		expected string
+	}{
+		{
+			name:     "by name",
+			importID: "test_application",
+			enabled:  []*model.Application{app},
+		},
+		{
+			name:     "by project and name",
+			importID: "test_project/test_application",
+			enabled:  []*model.Application{app},
+		},
+		{
+			name:     "disabled application by name",
+			importID: "test_application",
+			disabled: []*model.Application{app},
+		},
+		{
+			name:     "in another project",
+			importID: "other_project/test_application",
+			enabled:  []*model.Application{app},
+			expected: "Application not found",
+		},
+		{
+			name:     "not found",
+			importID: "test_application",
+			expected: "Application not found",
+		},
+		{
+			name:     "ambiguous name",
+			importID: "test_application",
+			enabled:  []*model.Application{app},
+			disabled: []*model.Application{other},
+			expected: "Multiple applications found",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			ctrl := gomock.NewController(t)
+			client := mock.NewMockAPIClient(ctrl)
+			client.EXPECT().ListApplications(gomock.Any(), protoEq(&apiservice.ListApplicationsRequest{Name: "test_application"})).
+				Return(&apiservice.ListApplicationsResponse{Applications: tc.enabled}, nil)
+			client.EXPECT().ListApplications(gomock.Any(), protoEq(&apiservice.ListApplicationsRequest{Name: "test_application", Disabled: true})).
+				Return(&apiservice.ListApplicationsResponse{Applications: tc.disabled}, nil)
+			if tc.expected == "" {
+				client.EXPECT().GetApplication(gomock.Any(), protoEq(&apiservice.GetApplicationRequest{ApplicationId: appID})).
+					Return(&apiservice.GetApplicationResponse{Application: app}, nil)
+			}
+
+			r := &ApplicationResource{c: client}
+			var schemaResp fwresource.SchemaResponse
+			r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+			resp := &fwresource.ImportStateResponse{
+				State: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+				},
+			}
+			r.ImportState(ctx, fwresource.ImportStateRequest{ID: tc.importID}, resp)
+
+			if tc.expected != "" {
+				if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != tc.expected {
+					t.Errorf("expected %q error, got %v", tc.expected, resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Errorf("unexpected errors: %v", resp.Diagnostics)
+				return
+			}
+			var state applicationResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+			if state.ID.ValueString() != appID || state.Name.ValueString() != "test_application" {
+				t.Errorf("unexpected state: %+v", state)
+			}
+		})
+	}
+}
+
+func TestApplicationResourceUpdate(t *testing.T) {
+	t.Parallel()
+
+	const appID = "test_application_id"
+
+	ctx := context.Background()
+
+	prior := applicationResourceModel{
+		ID:               types.StringValue(appID),
+		Name:             types.StringValue("test_application"),
+		PipedID:          types.StringValue("test_piped_id"),
+		Kind:             types.StringValue("KUBERNETES"),
+		PlatformProvider: types.StringValue("test_provider"),
+		Description:      types.StringValue("test description"),
+		Git: &applicationResourceGitModel{
+			RepositoryID: types.StringValue("repo_id"),
+			Remote:       types.StringValue("git@github.com:pipe-cd/examples.git"),
+			Branch:       types.StringValue("main"),
+			Path:         types.StringValue("path/to/config"),
+			Filename:     types.StringValue("app.pipecd.yaml"),
+		},
+		Labels:  labelsValue(nil),
+		Enabled: types.BoolValue(true),
+	}
+	plan := prior
+	plan.PlatformProvider = types.StringValue("new_provider")
+
+	updateReq := &apiservice.UpdateApplicationRequest{
+		ApplicationId:    appID,
+		PipedId:          "test_piped_id",
+		PlatformProvider: "new_provider",
+		GitPath: &model.ApplicationGitPath{
+			Repo:           &model.ApplicationGitRepository{Id: "repo_id"},
+			Path:           "path/to/config",
+			ConfigFilename: "app.pipecd.yaml",
+		},
+	}
+	// The branch of the repository and the labels were changed in the meantime.
+	updated := &model.Application{
+		Id:      appID,
+		Name:    "test_application",
+		PipedId: "test_piped_id",
+		GitPath: &model.ApplicationGitPath{
+			Repo: &model.ApplicationGitRepository{
+				Id:     "repo_id",
+				Remote: "git@github.com:pipe-cd/examples.git",
+				Branch: "develop",
+			},
+			Path:           "path/to/config",
+			ConfigFilename: "app.pipecd.yaml",
+		},
+		Kind:             model.ApplicationKind_KUBERNETES,
+		PlatformProvider: "new_provider",
+		Description:      "test description",
+		Labels:           map[string]string{"team": "payments"},
+	}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	gomock.InOrder(
+		client.EXPECT().UpdateApplication(gomock.Any(), protoEq(updateReq)).Return(&apiservice.UpdateApplicationResponse{ApplicationId: appID}, nil),
+		client.EXPECT().GetApplication(gomock.Any(), protoEq(&apiservice.GetApplicationRequest{ApplicationId: appID})).
+			Return(&apiservice.GetApplicationResponse{Application: updated}, nil),
+	)
+
+	r := &ApplicationResource{c: client}
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := state.Set(ctx, &prior); diags.HasError() {
+		t.Errorf("failed to set prior state: %v", diags)
+		return
+	}
+	planned := tfsdk.Plan{Schema: schemaResp.Schema}
+	if diags := planned.Set(ctx, &plan); diags.HasError() {
+		t.Errorf("failed to set plan: %v", diags)
+		return
+	}
+	resp := &fwresource.UpdateResponse{State: state}
+	r.Update(ctx, fwresource.UpdateRequest{Plan: planned, State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("unexpected errors: %v", resp.Diagnostics)
+		return
+	}
+
+	var got applicationResourceModel
+	if diags := resp.State.Get(ctx, &got); diags.HasError() {
+		t.Errorf("failed to get state: %v", diags)
+		return
+	}
+	expected := plan
+	expected.Git = &applicationResourceGitModel{
+		RepositoryID: types.StringValue("repo_id"),
+		Remote:       types.StringValue("git@github.com:pipe-cd/examples.git"),
+		Branch:       types.StringValue("develop"),
+		Path:         types.StringValue("path/to/config"),
+		Filename:     types.StringValue("app.pipecd.yaml"),
+	}
+	expected.Labels = labelsValue(map[string]string{"team": "payments"})
+	if !cmp.Equal(expected, got) {
+		t.Errorf("unexpected state (-want +got):\n%s", cmp.Diff(expected, got))
+	}
+}
+
+func TestApplicationResourceCreateReadBackFails(t *testing.T) {
+	t.Parallel()
+
+	const appID = "test_application_id"
+
+	ctx := context.Background()
+
+	// The description is not configured, and the computed attributes are unknown until the application is read back.
+	plan := applicationResourceModel{
+		ID:               types.StringUnknown(),
+		Name:             types.StringValue("test_application"),
+		PipedID:          types.StringValue("test_piped_id"),
+		Kind:             types.StringValue("KUBERNETES"),
+		PlatformProvider: types.StringValue("test_provider"),
+		Description:      types.StringUnknown(),
+		Git: &applicationResourceGitModel{
+			RepositoryID: types.StringValue("repo_id"),
+			Remote:       types.StringUnknown(),
+			Branch:       types.StringUnknown(),
+			Path:         types.StringValue("path/to/config"),
+			Filename:     types.StringValue("app.pipecd.yaml"),
+		},
+		Labels:  types.MapUnknown(types.StringType),
+		Enabled: types.BoolValue(false),
+	}
+
+	testcases := []struct {
+		name     string
+		setup    func(client *mock.MockAPIClient)
+		expected string
+	}{
+		{
+			name: "disable fails",
+			setup: func(client *mock.MockAPIClient) {
+				client.EXPECT().DisableApplication(gomock.Any(), protoEq(&apiservice.DisableApplicationRequest{ApplicationId: appID})).
+					Return(nil, status.Error(codes.Unavailable, "unavailable"))
+			},
+			expected: "Error disabling application",
+		},
+		{
+			name: "get fails",
+			setup: func(client *mock.MockAPIClient) {
+				client.EXPECT().DisableApplication(gomock.Any(), protoEq(&apiservice.DisableApplicationRequest{ApplicationId: appID})).
+					Return(&apiservice.DisableApplicationResponse{}, nil)
+				client.EXPECT().GetApplication(gomock.Any(), protoEq(&apiservice.GetApplicationRequest{ApplicationId: appID})).
+					Return(nil, status.Error(codes.DeadlineExceeded, "timeout"))
+			},
+			expected: "Error getting application",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			client := mock.NewMockAPIClient(ctrl)
+			expectEnabledPiped(client, "test_piped_id")
+			client.EXPECT().AddApplication(gomock.Any(), gomock.Any()).Return(&apiservice.AddApplicationResponse{ApplicationId: appID}, nil)
+			tc.setup(client)
+
+			r := &ApplicationResource{c: client}
+			var schemaResp fwresource.SchemaResponse
+			r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+			planned := tfsdk.Plan{Schema: schemaResp.Schema}
+			if diags := planned.Set(ctx, &plan); diags.HasError() {
+				t.Errorf("failed to set plan: %v", diags)
+				return
+			}
+			resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			r.Create(ctx, fwresource.CreateRequest{Plan: planned}, resp)
+
+			if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics.Errors()[0].Summary() != tc.expected {
+				t.Errorf("expected a single %q error, got: %v", tc.expected, resp.Diagnostics)
+			}
+			// Terraform rejects a state with unknown values even when the apply fails.
+			if !resp.State.Raw.IsFullyKnown() {
+				t.Errorf("state has unknown values: %v", resp.State.Raw)
+			}
+
+			var got applicationResourceModel
+			if diags := resp.State.Get(ctx, &got); diags.HasError() {
+				t.Errorf("failed to get state: %v", diags)
+				return
+			}
+			if got.ID.ValueString() != appID || got.Description.ValueString() != "" {
+				t.Errorf("unexpected state: %+v", got)
+			}
+		})
+	}
+}
+
+func TestApplicationResourceRead(t *testing.T) {
+	t.Parallel()
+
+	const appID = "test_application_id"
+
+	ctx := context.Background()
+
+	prior := applicationResourceModel{
+		ID:               types.StringValue(appID),
+		Name:             types.StringValue("test_application"),
+		PipedID:          types.StringValue("test_piped_id"),
+		Kind:             types.StringValue("KUBERNETES"),
+		PlatformProvider: types.StringValue("test_provider"),
+		Description:      types.StringValue("test description"),
+		Git: &applicationResourceGitModel{
+			RepositoryID: types.StringValue("repo_id"),
+			Remote:       types.StringValue("git@github.com:pipe-cd/examples.git"),
+			Branch:       types.StringValue("main"),
+			Path:         types.StringValue("path/to/config"),
+			Filename:     types.StringValue("app.pipecd.yaml"),
+		},
+		Labels: labelsValue(map[string]string{"team": "a"}),
+	}
+
+	// The application was changed through the web UI.
+	drifted := &model.Application{
+		Id:      appID,
+		Name:    "renamed_application",
+		PipedId: "other_piped_id",
+		GitPath: &model.ApplicationGitPath{
+			Repo: &model.ApplicationGitRepository{
+				Id:     "other_repo_id",
+				Remote: "git@github.com:pipe-cd/other.git",
+				Branch: "develop",
+			},
+			Path:           "other/path",
+			ConfigFilename: "other.pipecd.yaml",
+		},
+		Kind:             model.ApplicationKind_KUBERNETES,
+		PlatformProvider: "other_provider",
+		Description:      "changed description",
+		Labels:           map[string]string{"team": "b", "env": "prod"},
+		Disabled:         true,
+	}
+
+	testcases := []struct {
+		name        string
+		getResp     *apiservice.GetApplicationResponse
+		getErr      error
+		expected    *applicationResourceModel
+		wantRemoved bool
+	}{
+		{
+			name:    "drift",
+			getResp: &apiservice.GetApplicationResponse{Application: drifted},
+			expected: &applicationResourceModel{
+				ID:               types.StringValue(appID),
+				Name:             types.StringValue("renamed_application"),
+				PipedID:          types.StringValue("other_piped_id"),
+				Kind:             types.StringValue("KUBERNETES"),
+				PlatformProvider: types.StringValue("other_provider"),
+				Description:      types.StringValue("changed description"),
+				Git: &applicationResourceGitModel{
+					RepositoryID: types.StringValue("other_repo_id"),
+					Remote:       types.StringValue("git@github.com:pipe-cd/other.git"),
+					Branch:       types.StringValue("develop"),
+					Path:         types.StringValue("other/path"),
+					Filename:     types.StringValue("other.pipecd.yaml"),
+				},
+				Labels:  labelsValue(map[string]string{"team": "b", "env": "prod"}),
+				Enabled: types.BoolValue(false),
+			},
+		},
+		{
+			name:        "deleted outside of Terraform",
+			getErr:      status.Error(codes.NotFound, "application not found"),
+			wantRemoved: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			client := mock.NewMockAPIClient(ctrl)
+			client.EXPECT().GetApplication(gomock.Any(), protoEq(&apiservice.GetApplicationRequest{ApplicationId: appID})).Return(tc.getResp, tc.getErr)
+
+			r := &ApplicationResource{c: client}
+			var schemaResp fwresource.SchemaResponse
+			r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+			state := tfsdk.State{Schema: schemaResp.Schema}
+			if diags := state.Set(ctx, &prior); diags.HasError() {
+				t.Errorf("failed to set prior state: %v", diags)
+				return
+			}
+			resp := &fwresource.ReadResponse{State: state}
+			r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Errorf("unexpected errors: %v", resp.Diagnostics)
+				return
+			}
+			if tc.wantRemoved {
+				if !resp.State.Raw.IsNull() {
+					t.Errorf("expected the resource to be removed from state, got %v", resp.State.Raw)
+				}
+				return
+			}
+
+			var got applicationResourceModel
+			if diags := resp.State.Get(ctx, &got); diags.HasError() {
+				t.Errorf("failed to get state: %v", diags)
+				return
+			}
+			if !cmp.Equal(*tc.expected, got) {
+				t.Errorf("unexpected state (-want +got):\n%s", cmp.Diff(*tc.expected, got))
+			}
+		})
+	}
+}
+
+func testAccResourceApplicationWithoutPipedID() string {
+	return `
+resource "pipecd_application" "test" {
+	name = "test_application"
+	kind = "KUBERNETES"
+	platform_provider = "test_provider"
+	git = {
+		repository_id = "repo_id"
+		path = "path/to/config"
+	}
+}`
+}
+
+func TestAccResourceApplicationImportFromApplications(t *testing.T) {
+	t.Parallel()
+
+	newApp := func(id, name string) *model.Application {
+		return &model.Application{
+			Id:      id,
+			Name:    name,
+			PipedId: "test_piped_id",
+			GitPath: &model.ApplicationGitPath{
+				Repo:           &model.ApplicationGitRepository{Id: "repo_id"},
+				Path:           "path/to/" + name,
+				ConfigFilename: "app.pipecd.yaml",
+			},
+			Kind:             model.ApplicationKind_KUBERNETES,
+			PlatformProvider: "test_provider",
+			Description:      "test description",
+			Labels:           map[string]string{"team": "payments"},
+		}
+	}
+	apps := []*model.Application{
+		newApp("3b9f1c2e-7a4d-4e1f-9c8b-2d6e5f4a3b21", "app-1"),
+		newApp("8c2d4e6f-1a3b-4c5d-8e9f-0a1b2c3d4e5f", "app-2"),
+	}
+
+	listReq := &apiservice.ListApplicationsRequest{Labels: map[string]string{"team": "payments"}}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	client.EXPECT().ListApplications(gomock.Any(), protoEq(listReq)).
+		Return(&apiservice.ListApplicationsResponse{Applications: apps}, nil).AnyTimes()
+	for _, app := range apps {
+		client.EXPECT().GetApplication(gomock.Any(), protoEq(&apiservice.GetApplicationRequest{ApplicationId: app.Id})).
+			Return(&apiservice.GetApplicationResponse{Application: app}, nil).AnyTimes()
+		client.EXPECT().DeleteApplication(gomock.Any(), protoEq(&apiservice.DeleteApplicationRequest{ApplicationId: app.Id})).
+			Return(&apiservice.DeleteApplicationResponse{ApplicationId: app.Id}, nil).Times(1)
+	}
+
+	// Each application listed by label is imported with the ID returned by the data source.
+	importStep := func(i int) resource.TestStep {
+		return resource.TestStep{
+			Config:             testAccResourceApplicationImportFromApplications(),
+			ResourceName:       fmt.Sprintf("pipecd_application.app_%d", i),
+			ImportState:        true,
+			ImportStatePersist: true,
+			ImportStateIdFunc: func(s *terraform.State) (string, error) {
+				return s.RootModule().Resources["data.pipecd_applications.test"].Primary.Attributes[fmt.Sprintf("applications.%d.id", i)], nil
+			},
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccDataSourceApplicationsByLabel,
+				Check:  resource.TestCheckResourceAttr("data.pipecd_applications.test", "applications.#", "2"),
+			},
+			importStep(0),
+			importStep(1),
+			{
+				// The imported applications match the configuration, so the plan is clean.
+				Config:   testAccResourceApplicationImportFromApplications(),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+const testAccDataSourceApplicationsByLabel = `
+data "pipecd_applications" "test" {
+	labels = {
+		team = "payments"
+	}
+}
+`
+
+func testAccResourceApplicationImportFromApplications() string {
+	config := providerConfig + testAccDataSourceApplicationsByLabel
+	for i, name := range []string{"app-1", "app-2"} {
+		config += fmt.Sprintf(`
+resource "pipecd_application" "app_%d" {
+	name = "%s"
+	piped_id = "test_piped_id"
+	kind = "KUBERNETES"
+	platform_provider = "test_provider"
+	description = "test description"
+	git = {
+		repository_id = "repo_id"
+		path = "path/to/%s"
+	}
+}
+`, i, name, name)
+	}
+	return config
 }
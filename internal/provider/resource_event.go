@@ -0,0 +1,180 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	api "github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+)
+
+var _ resource.Resource = &EventResource{}
+
+func NewEventResource() resource.Resource {
+	return &EventResource{}
+}
+
+// EventResource registers events for the EventWatcher of PipeCD.
+// Events are append-only, so every change registers a new event.
+type EventResource struct {
+	c     APIClient
+	debug bool
+}
+
+type eventResourceModel struct {
+	EventID types.String `tfsdk:"event_id"`
+	Name    types.String `tfsdk:"name"`
+	Data    types.String `tfsdk:"data"`
+	Labels  types.Map    `tfsdk:"labels"`
+}
+
+func (e *EventResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_event"
+}
+
+func (e *EventResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "PipeCD event resource. It registers an event handled by the EventWatcher of pipeds, " +
+			"e.g. to update the manifests in Git after an infrastructure change. " +
+			"Events cannot be deleted, so changing any attribute registers a new event and destroying only removes it from the state.",
+
+		Attributes: map[string]schema.Attribute{
+			"event_id": schema.StringAttribute{
+				Description: "The ID of the registered event.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the event, matched against the event watcher configuration.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					nameValidator{},
+				},
+			},
+			"data": schema.StringAttribute{
+				Description: "The data of the event, e.g. the new image tag.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"labels": schema.MapAttribute{
+				Description: "The labels of the event, matched against the event watcher configuration.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (e *EventResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan eventResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var labels map[string]string
+	diags = plan.Labels.ElementsAs(ctx, &labels, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	registerReq := &api.RegisterEventRequest{
+		Name:   plan.Name.ValueString(),
+		Data:   plan.Data.ValueString(),
+		Labels: labels,
+	}
+	registerResp, err := e.c.RegisterEvent(ctx, registerReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error registering event",
+			"Could not register event, unexpected error: "+errorDetail(err, e.debug),
+		)
+		return
+	}
+
+	plan.EventID = types.StringValue(registerResp.EventId)
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read keeps the state as is, as PipeCD provides no API to get a registered event.
+func (e *EventResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state eventResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is never called as every attribute requires replacement.
+func (e *EventResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan eventResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (e *EventResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state eventResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	log.Printf("[WARNING] PipeCD Event resources"+
+		" cannot be deleted. The event %s will be removed from Terraform"+
+		" state, but will still be present on PipeCD Control Plane.", state.EventID.ValueString())
+}
+
+func (e *EventResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	e.c = data.c
+	e.debug = data.debug
+}
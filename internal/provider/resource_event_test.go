@@ -0,0 +1,88 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/terraform-provider-pipecd/internal/provider/mock"
+)
+
+func TestAccResourceEvent(t *testing.T) {
+	t.Parallel()
+
+	registerReq := &apiservice.RegisterEventRequest{
+		Name:   "image-update",
+		Data:   "v0.1.0",
+		Labels: map[string]string{"app": "helloworld"},
+	}
+	reregisterReq := &apiservice.RegisterEventRequest{
+		Name:   "image-update",
+		Data:   "v0.2.0",
+		Labels: map[string]string{"app": "helloworld"},
+	}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	gomock.InOrder(
+		client.EXPECT().RegisterEvent(gomock.Any(), protoEq(registerReq)).Return(&apiservice.RegisterEventResponse{EventId: "event-1"}, nil),
+		client.EXPECT().RegisterEvent(gomock.Any(), protoEq(reregisterReq)).Return(&apiservice.RegisterEventResponse{EventId: "event-2"}, nil),
+	)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceEvent("image-update", "v0.1.0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pipecd_event.test", "event_id", "event-1"),
+					resource.TestCheckResourceAttr("pipecd_event.test", "name", "image-update"),
+					resource.TestCheckResourceAttr("pipecd_event.test", "data", "v0.1.0"),
+					resource.TestCheckResourceAttr("pipecd_event.test", "labels.app", "helloworld"),
+				),
+			},
+			{
+				// Events cannot be updated, so a new one is registered.
+				Config: testAccResourceEvent("image-update", "v0.2.0"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pipecd_event.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pipecd_event.test", "event_id", "event-2"),
+					resource.TestCheckResourceAttr("pipecd_event.test", "data", "v0.2.0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceEvent(name, data string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "pipecd_event" "test" {
+	name = "%s"
+	data = "%s"
+	labels = {
+		app = "helloworld"
+	}
+}`, name, data)
+}
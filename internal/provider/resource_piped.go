@@ -17,12 +17,17 @@ package provider
 import (
 	"context"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	api "github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
 	"github.com/pipe-cd/pipecd/pkg/model"
@@ -38,37 +43,51 @@ func NewPipedResource() resource.Resource {
 }
 
 type PipedResource struct {
-	c APIClient
+	c     APIClient
+	debug bool
 }
 
 type (
 	pipedResourceModel struct {
-		ID          types.String `tfsdk:"id"`
-		Name        types.String `tfsdk:"name"`
-		Description types.String `tfsdk:"description"`
-		APIKey      types.String `tfsdk:"api_key"`
+		ID                   types.String `tfsdk:"id"`
+		Name                 types.String `tfsdk:"name"`
+		Description          types.String `tfsdk:"description"`
+		APIKey               types.String `tfsdk:"api_key"`
+		WaitForOnline        types.Bool   `tfsdk:"wait_for_online"`
+		WaitForOnlineTimeout types.String `tfsdk:"wait_for_online_timeout"`
 	}
 )
 
+const (
+	defaultWaitForOnlineTimeout = "5m"
+	pipedOnlinePollInterval     = 10 * time.Second
+)
+
 func (p *PipedResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	getReq := &api.GetPipedRequest{
 		PipedId: req.ID,
 	}
-
-	getResp, err := p.c.GetPiped(ctx, getReq)
+	var getResp *api.GetPipedResponse
+	err := retryOnNotFound(ctx, importRetryAttempts, importRetryInterval, func(ctx context.Context) error {
+		var err error
+		getResp, err = p.c.GetPiped(ctx, getReq)
+		return err
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading piped",
-			"Could not read piped, unexpected error: "+err.Error(),
+			"Could not read piped, unexpected error: "+errorDetail(err, p.debug),
 		)
 		return
 	}
 
 	state := pipedResourceModel{
-		ID:          types.StringValue(req.ID),
-		Name:        types.StringValue(getResp.Piped.Name),
-		Description: types.StringValue(getResp.Piped.Desc),
-		APIKey:      types.StringUnknown(),
+		ID:                   types.StringValue(req.ID),
+		Name:                 types.StringValue(getResp.Piped.Name),
+		Description:          types.StringValue(getResp.Piped.Desc),
+		APIKey:               types.StringUnknown(),
+		WaitForOnline:        types.BoolValue(false),
+		WaitForOnlineTimeout: types.StringValue(defaultWaitForOnlineTimeout),
 	}
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -93,6 +112,9 @@ func (p *PipedResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			"name": schema.StringAttribute{
 				Description: "The piped name.",
 				Required:    true,
+				Validators: []validator.String{
+					nameValidator{},
+				},
 			},
 			"description": schema.StringAttribute{
 				Description: "The description of the piped.",
@@ -107,6 +129,22 @@ func (p *PipedResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"wait_for_online": schema.BoolAttribute{
+				Description: "Whether to wait on create until the registered piped connects to the control plane. " +
+					"If it does not come online in time, a warning is reported and the piped is kept, so that its API key stays valid. (default false)",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"wait_for_online_timeout": schema.StringAttribute{
+				Description: "How long to wait for the piped to come online when wait_for_online is set. (default \"" + defaultWaitForOnlineTimeout + "\")",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(defaultWaitForOnlineTimeout),
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
 		},
 	}
 }
@@ -138,19 +176,49 @@ func (p *PipedResource) Create(ctx context.Context, req resource.CreateRequest,
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating piped",
-			"Could not create piped, unexpected error: "+err.Error(),
+			"Could not create piped, unexpected error: "+errorDetail(err, p.debug),
 		)
 		return
 	}
 
 	plan = pipedResourceModel{
-		ID:          types.StringValue(registerResp.Id),
-		Name:        types.StringValue(piped.Name),
-		Description: types.StringValue(piped.Desc),
-		APIKey:      types.StringValue(registerResp.Key),
+		ID:                   types.StringValue(registerResp.Id),
+		Name:                 types.StringValue(piped.Name),
+		Description:          types.StringValue(piped.Desc),
+		APIKey:               types.StringValue(registerResp.Key),
+		WaitForOnline:        plan.WaitForOnline,
+		WaitForOnlineTimeout: plan.WaitForOnlineTimeout,
 	}
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !plan.WaitForOnline.ValueBool() {
+		return
+	}
+
+	timeout, _ := time.ParseDuration(plan.WaitForOnlineTimeout.ValueString())
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// An error would taint the piped, so that the next apply would register it again with a new API key.
+	if err := waitForPipedOnline(waitCtx, p.c, registerResp.Id, pipedOnlinePollInterval); err != nil {
+		resp.Diagnostics.AddWarning(
+			"Piped did not come online",
+			"The piped "+registerResp.Id+" was registered but did not come online within "+timeout.String()+": "+err.Error()+"\n\n"+
+				"The piped and its API key are kept. Check that the piped is running with the API key.",
+		)
+	}
+}
+
+// waitForPipedOnline polls the piped status every interval until it reports online.
+func waitForPipedOnline(ctx context.Context, c APIClient, pipedID string, interval time.Duration) error {
+	return poll(ctx, interval, func(ctx context.Context) (bool, error) {
+		getResp, err := c.GetPiped(ctx, &api.GetPipedRequest{PipedId: pipedID})
+		if err != nil {
+			return false, err
+		}
+		tflog.Debug(ctx, "Waiting for piped to come online", map[string]interface{}{"piped_id": pipedID, "status": getResp.Piped.Status.String()})
+		return getResp.Piped.Status == model.Piped_ONLINE, nil
+	})
 }
 
 func (p *PipedResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -161,6 +229,27 @@ func (p *PipedResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	getReq := &api.GetPipedRequest{
+		PipedId: state.ID.ValueString(),
+	}
+	getResp, err := p.c.GetPiped(ctx, getReq)
+	if isNotFound(err) {
+		// The piped was deleted outside of Terraform, so let Terraform create it again.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading piped",
+			"Could not read piped, unexpected error: "+errorDetail(err, p.debug),
+		)
+		return
+	}
+
+	// The API key is only returned on registration, so it is kept as is.
+	state.Name = types.StringValue(getResp.Piped.Name)
+	state.Description = types.StringValue(getResp.Piped.Desc)
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
@@ -184,7 +273,7 @@ func (p *PipedResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating piped",
-			"Could not update piped, unexpected error: "+err.Error(),
+			"Could not update piped, unexpected error: "+errorDetail(err, p.debug),
 		)
 		return
 	}
@@ -212,7 +301,7 @@ func (p *PipedResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Disabling PipeCD piped",
-			"Could not disable piped, unexpected error: "+err.Error(),
+			"Could not disable piped, unexpected error: "+errorDetail(err, p.debug),
 		)
 		return
 	}
@@ -223,5 +312,7 @@ func (p *PipedResource) Configure(_ context.Context, req resource.ConfigureReque
 		return
 	}
 
-	p.c = req.ProviderData.(APIClient)
+	data := req.ProviderData.(*providerData)
+	p.c = data.c
+	p.debug = data.debug
 }
@@ -15,11 +15,24 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"regexp"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
 	"github.com/pipe-cd/pipecd/pkg/model"
@@ -59,10 +72,10 @@ func TestAccResourcePiped(t *testing.T) {
 
 	ctrl := gomock.NewController(t)
 	client := mock.NewMockAPIClient(ctrl)
-	client.EXPECT().RegisterPiped(gomock.Any(), registerReq).Return(registerResp, nil).AnyTimes()
-	client.EXPECT().UpdatePiped(gomock.Any(), updateReq).Return(updateResp, nil).AnyTimes()
-	client.EXPECT().GetPiped(gomock.Any(), getReq).Return(getResp, nil).AnyTimes()
-	client.EXPECT().DisablePiped(gomock.Any(), disableReq).Return(disableResp, nil).AnyTimes()
+	client.EXPECT().RegisterPiped(gomock.Any(), protoEq(registerReq)).Return(registerResp, nil).AnyTimes()
+	client.EXPECT().UpdatePiped(gomock.Any(), protoEq(updateReq)).Return(updateResp, nil).AnyTimes()
+	client.EXPECT().GetPiped(gomock.Any(), protoEq(getReq)).Return(getResp, nil).AnyTimes()
+	client.EXPECT().DisablePiped(gomock.Any(), protoEq(disableReq)).Return(disableResp, nil).AnyTimes()
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
@@ -80,6 +93,123 @@ func TestAccResourcePiped(t *testing.T) {
 	})
 }
 
+func TestAccResourcePipedInvalidName(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourcePiped(`test\tpiped`, "test description"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Name"),
+			},
+		},
+	})
+}
+
+func TestAccResourcePipedRename(t *testing.T) {
+	t.Parallel()
+
+	const pipedID = "test_piped_id"
+
+	// piped is the piped stored in the control plane.
+	var mu sync.Mutex
+	piped := &model.Piped{
+		Id:   pipedID,
+		Name: "test_piped",
+		Desc: "test description",
+	}
+	setName := func(name string) {
+		mu.Lock()
+		defer mu.Unlock()
+		piped = &model.Piped{Id: pipedID, Name: name, Desc: piped.Desc}
+	}
+
+	registerReq := &apiservice.RegisterPipedRequest{
+		Name: "test_piped",
+		Desc: "test description",
+	}
+	registerResp := &apiservice.RegisterPipedResponse{Id: pipedID, Key: "test_piped_api_key"}
+
+	updateReq := &apiservice.UpdatePipedRequest{
+		PipedId: pipedID,
+		Name:    "renamed_piped",
+		Desc:    "test description",
+	}
+
+	getReq := &apiservice.GetPipedRequest{PipedId: pipedID}
+
+	disableReq := &apiservice.DisablePipedRequest{PipedId: pipedID}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	client.EXPECT().RegisterPiped(gomock.Any(), protoEq(registerReq)).Return(registerResp, nil).AnyTimes()
+	client.EXPECT().UpdatePiped(gomock.Any(), protoEq(updateReq)).DoAndReturn(
+		func(_ context.Context, req *apiservice.UpdatePipedRequest, _ ...grpc.CallOption) (*apiservice.UpdatePipedResponse, error) {
+			setName(req.Name)
+			return &apiservice.UpdatePipedResponse{}, nil
+		},
+	).Times(1)
+	client.EXPECT().GetPiped(gomock.Any(), protoEq(getReq)).DoAndReturn(
+		func(_ context.Context, _ *apiservice.GetPipedRequest, _ ...grpc.CallOption) (*apiservice.GetPipedResponse, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			return &apiservice.GetPipedResponse{Piped: proto.Clone(piped).(*model.Piped)}, nil
+		},
+	).AnyTimes()
+	client.EXPECT().DisablePiped(gomock.Any(), protoEq(disableReq)).Return(&apiservice.DisablePipedResponse{}, nil).AnyTimes()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(client),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePiped("test_piped", "test description"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pipecd_piped.test", "name", "test_piped"),
+				),
+			},
+			{
+				// Renamed through Terraform.
+				Config: testAccResourcePiped("renamed_piped", "test description"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pipecd_piped.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pipecd_piped.test", "name", "renamed_piped"),
+				),
+			},
+			{
+				// Renamed outside of Terraform, e.g. in the web console.
+				PreConfig: func() {
+					setName("renamed_in_console")
+				},
+				Config:             testAccResourcePiped("renamed_piped", "test description"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// The name is set back in the web console, which is picked up on refresh.
+				PreConfig: func() {
+					setName("renamed_piped")
+				},
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pipecd_piped.test", "name", "renamed_piped"),
+				),
+			},
+		},
+	})
+}
+
 func testAccResourcePiped(name, desc string) string {
 	return providerConfig + fmt.Sprintf(`
 resource "pipecd_piped" "test" {
@@ -87,3 +217,164 @@ resource "pipecd_piped" "test" {
 	description = "%s"
 }`, name, desc)
 }
+
+func TestWaitForPipedOnline(t *testing.T) {
+	t.Parallel()
+
+	const pipedID = "test_piped_id"
+
+	getReq := &apiservice.GetPipedRequest{PipedId: pipedID}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	gomock.InOrder(
+		client.EXPECT().GetPiped(gomock.Any(), protoEq(getReq)).Return(&apiservice.GetPipedResponse{
+			Piped: &model.Piped{Id: pipedID, Status: model.Piped_OFFLINE},
+		}, nil),
+		client.EXPECT().GetPiped(gomock.Any(), protoEq(getReq)).Return(&apiservice.GetPipedResponse{
+			Piped: &model.Piped{Id: pipedID, Status: model.Piped_ONLINE},
+		}, nil),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := waitForPipedOnline(ctx, client, pipedID, 10*time.Millisecond); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestPipedResourceCreateWaitForOnlineTimeout(t *testing.T) {
+	t.Parallel()
+
+	const pipedID = "test_piped_id"
+
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	client.EXPECT().RegisterPiped(gomock.Any(), protoEq(&apiservice.RegisterPipedRequest{Name: "test_piped"})).
+		Return(&apiservice.RegisterPipedResponse{Id: pipedID, Key: "test_api_key"}, nil)
+	client.EXPECT().GetPiped(gomock.Any(), protoEq(&apiservice.GetPipedRequest{PipedId: pipedID})).
+		Return(&apiservice.GetPipedResponse{Piped: &model.Piped{Id: pipedID, Status: model.Piped_OFFLINE}}, nil).MinTimes(1)
+
+	r := &PipedResource{c: client}
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	plan := pipedResourceModel{
+		ID:                   types.StringUnknown(),
+		Name:                 types.StringValue("test_piped"),
+		Description:          types.StringValue(""),
+		APIKey:               types.StringUnknown(),
+		WaitForOnline:        types.BoolValue(true),
+		WaitForOnlineTimeout: types.StringValue("10ms"),
+	}
+	planned := tfsdk.Plan{Schema: schemaResp.Schema}
+	if diags := planned.Set(ctx, &plan); diags.HasError() {
+		t.Errorf("failed to set plan: %v", diags)
+		return
+	}
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: planned}, resp)
+
+	// The timeout is not an error, so that the piped is not tainted and registered again with a new API key.
+	if resp.Diagnostics.HasError() {
+		t.Errorf("unexpected errors: %v", resp.Diagnostics)
+		return
+	}
+	if resp.Diagnostics.WarningsCount() != 1 || resp.Diagnostics.Warnings()[0].Summary() != "Piped did not come online" {
+		t.Errorf("expected a warning about the piped not coming online, got: %v", resp.Diagnostics)
+	}
+
+	var got pipedResourceModel
+	if diags := resp.State.Get(ctx, &got); diags.HasError() {
+		t.Errorf("failed to get state: %v", diags)
+		return
+	}
+	if got.ID.ValueString() != pipedID || got.APIKey.ValueString() != "test_api_key" {
+		t.Errorf("unexpected state: %+v", got)
+	}
+}
+
+func TestPipedResourceImportStateRetry(t *testing.T) {
+	t.Parallel()
+
+	const pipedID = "test_piped_id"
+
+	ctx := context.Background()
+
+	getReq := &apiservice.GetPipedRequest{PipedId: pipedID}
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	gomock.InOrder(
+		client.EXPECT().GetPiped(gomock.Any(), protoEq(getReq)).Return(nil, status.Error(codes.NotFound, "piped not found")),
+		client.EXPECT().GetPiped(gomock.Any(), protoEq(getReq)).Return(&apiservice.GetPipedResponse{
+			Piped: &model.Piped{Id: pipedID, Name: "test_piped"},
+		}, nil),
+	)
+
+	r := &PipedResource{c: client}
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	resp := &fwresource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	r.ImportState(ctx, fwresource.ImportStateRequest{ID: pipedID}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("unexpected errors: %v", resp.Diagnostics)
+		return
+	}
+	var state pipedResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if state.ID.ValueString() != pipedID || state.Name.ValueString() != "test_piped" {
+		t.Errorf("unexpected state: %+v", state)
+	}
+}
+
+func TestPipedResourceReadNotFound(t *testing.T) {
+	t.Parallel()
+
+	const pipedID = "test_piped_id"
+
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockAPIClient(ctrl)
+	client.EXPECT().GetPiped(gomock.Any(), protoEq(&apiservice.GetPipedRequest{PipedId: pipedID})).
+		Return(nil, status.Error(codes.NotFound, "piped not found"))
+
+	r := &PipedResource{c: client}
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	prior := pipedResourceModel{
+		ID:                   types.StringValue(pipedID),
+		Name:                 types.StringValue("test_piped"),
+		Description:          types.StringValue("test description"),
+		APIKey:               types.StringValue("test_api_key"),
+		WaitForOnline:        types.BoolNull(),
+		WaitForOnlineTimeout: types.StringNull(),
+	}
+	if diags := state.Set(ctx, &prior); diags.HasError() {
+		t.Errorf("failed to set prior state: %v", diags)
+		return
+	}
+	resp := &fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("unexpected errors: %v", resp.Diagnostics)
+		return
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Errorf("expected the resource to be removed from state, got %v", resp.State.Raw)
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = durationValidator{}
+
+// durationValidator validates that a string is a positive duration parsable by time.ParseDuration.
+type durationValidator struct{}
+
+func (v durationValidator) Description(_ context.Context) string {
+	return `value must be a positive duration such as "30s" or "5m"`
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	d, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			"The "+req.Path.String()+" "+v.Description(ctx)+`, got "`+req.ConfigValue.ValueString()+`".`,
+		)
+	}
+}
+
+var _ validator.String = nameValidator{}
+
+// nameValidator validates the names of PipeCD resources.
+// PipeCD only requires names to be non-empty, so no maximum length is enforced.
+type nameValidator struct{}
+
+func (v nameValidator) Description(_ context.Context) string {
+	return "value must be a non-empty UTF-8 string without control characters"
+}
+
+func (v nameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v nameValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	name := req.ConfigValue.ValueString()
+	if !isValidName(name) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Name",
+			"The "+req.Path.String()+" "+v.Description(ctx)+", got "+strconv.Quote(name)+".",
+		)
+		return
+	}
+
+	if strings.TrimSpace(name) != name {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path,
+			"Name Has Leading or Trailing Whitespace",
+			"The "+req.Path.String()+" "+strconv.Quote(name)+" starts or ends with whitespace, which is easy to miss in the PipeCD web console.",
+		)
+	}
+}
+
+// isValidName reports whether name is a non-empty UTF-8 string without control characters.
+func isValidName(name string) bool {
+	return name != "" && utf8.ValidString(name) && strings.IndexFunc(name, unicode.IsControl) < 0
+}
+
+var _ validator.String = jsonObjectValidator{}
+
+// jsonObjectValidator validates that a string is a JSON object.
+type jsonObjectValidator struct{}
+
+func (v jsonObjectValidator) Description(_ context.Context) string {
+	return "value must be a JSON object"
+}
+
+func (v jsonObjectValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jsonObjectValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &obj); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON",
+			"The "+req.Path.String()+" "+v.Description(ctx)+": "+err.Error(),
+		)
+	}
+}
+
+var _ validator.String = deploymentStatusValidator{}
+
+// deploymentStatusValidator validates that a string is a deployment status accepted by DeploymentStatusFromString.
+type deploymentStatusValidator struct{}
+
+func (v deploymentStatusValidator) Description(_ context.Context) string {
+	return "value must be one of: " + strings.Join(DeploymentStatusValues(), ", ")
+}
+
+func (v deploymentStatusValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v deploymentStatusValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := DeploymentStatusFromString(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Deployment Status",
+			"The "+req.Path.String()+" has an "+err.Error()+".",
+		)
+	}
+}
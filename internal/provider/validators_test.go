@@ -0,0 +1,185 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNameValidator(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		value       types.String
+		wantError   bool
+		wantWarning bool
+	}{
+		{
+			name:  "valid",
+			value: types.StringValue("my-application"),
+		},
+		{
+			name:  "long name",
+			value: types.StringValue("a-very-long-application-name-that-is-still-accepted-because-pipecd-has-no-length-limit"),
+		},
+		{
+			name:  "null",
+			value: types.StringNull(),
+		},
+		{
+			name:  "unknown",
+			value: types.StringUnknown(),
+		},
+		{
+			name:      "empty",
+			value:     types.StringValue(""),
+			wantError: true,
+		},
+		{
+			name:      "tab",
+			value:     types.StringValue("my\tapplication"),
+			wantError: true,
+		},
+		{
+			name:      "newline",
+			value:     types.StringValue("my-application\n"),
+			wantError: true,
+		},
+		{
+			name:      "invalid UTF-8",
+			value:     types.StringValue("my-\xffapplication"),
+			wantError: true,
+		},
+		{
+			name:        "leading and trailing whitespace",
+			value:       types.StringValue(" my-application "),
+			wantWarning: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				Path:        path.Root("name"),
+				ConfigValue: tc.value,
+			}
+			var resp validator.StringResponse
+			nameValidator{}.ValidateString(context.Background(), req, &resp)
+
+			if got := resp.Diagnostics.ErrorsCount() > 0; got != tc.wantError {
+				t.Errorf("expected error to be %t, got diagnostics %v", tc.wantError, resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.WarningsCount() > 0; got != tc.wantWarning {
+				t.Errorf("expected warning to be %t, got diagnostics %v", tc.wantWarning, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestJSONObjectValidator(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name      string
+		value     types.String
+		wantError bool
+	}{
+		{
+			name:  "object",
+			value: types.StringValue(`{"methodConfig": []}`),
+		},
+		{
+			name:  "null",
+			value: types.StringNull(),
+		},
+		{
+			name:      "invalid JSON",
+			value:     types.StringValue(`{"methodConfig": [}`),
+			wantError: true,
+		},
+		{
+			name:      "not an object",
+			value:     types.StringValue(`[]`),
+			wantError: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				Path:        path.Root("grpc_service_config"),
+				ConfigValue: tc.value,
+			}
+			var resp validator.StringResponse
+			jsonObjectValidator{}.ValidateString(context.Background(), req, &resp)
+
+			if got := resp.Diagnostics.HasError(); got != tc.wantError {
+				t.Errorf("expected error to be %t, got diagnostics %v", tc.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestDeploymentStatusValidator(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name      string
+		value     types.String
+		wantError bool
+	}{
+		{
+			name:  "short form",
+			value: types.StringValue("SUCCESS"),
+		},
+		{
+			name:  "enum name",
+			value: types.StringValue("DEPLOYMENT_FAILURE"),
+		},
+		{
+			name:  "null",
+			value: types.StringNull(),
+		},
+		{
+			name:      "unknown status",
+			value:     types.StringValue("DONE"),
+			wantError: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				Path:        path.Root("statuses").AtListIndex(0),
+				ConfigValue: tc.value,
+			}
+			var resp validator.StringResponse
+			deploymentStatusValidator{}.ValidateString(context.Background(), req, &resp)
+
+			if got := resp.Diagnostics.HasError(); got != tc.wantError {
+				t.Errorf("expected error to be %t, got diagnostics %v", tc.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
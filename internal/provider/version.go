@@ -0,0 +1,50 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"runtime/debug"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const pipecdModulePath = "github.com/pipe-cd/pipecd"
+
+// pipecdVersion returns the version of the pipecd module the provider was built against,
+// or "unknown" when the build information is not available.
+func pipecdVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "unknown"
+	}
+	for _, dep := range info.Deps {
+		if dep.Path != pipecdModulePath {
+			continue
+		}
+		if dep.Replace != nil {
+			return dep.Replace.Version
+		}
+		return dep.Version
+	}
+	return "unknown"
+}
+
+// logPipeCDVersion logs the pipecd version the provider was built against.
+// Field mismatches with the control plane are usually caused by version skew, so this helps triaging them.
+func logPipeCDVersion(ctx context.Context) {
+	v := pipecdVersion()
+	tflog.Info(ctx, "Provider was built against PipeCD "+v, map[string]interface{}{"pipecd_version": v})
+}
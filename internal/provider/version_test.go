@@ -0,0 +1,42 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestLogPipeCDVersion(t *testing.T) {
+	t.Parallel()
+
+	var logs bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &logs)
+
+	logPipeCDVersion(ctx)
+
+	v := pipecdVersion()
+	if v == "unknown" || !strings.HasPrefix(v, "v") {
+		t.Errorf("expected the pipecd module version from the build info, got %q", v)
+		return
+	}
+	if !strings.Contains(logs.String(), `"pipecd_version":"`+v+`"`) {
+		t.Errorf("expected pipecd version %q in the logs, got %q", v, logs.String())
+	}
+}